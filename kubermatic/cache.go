@@ -0,0 +1,91 @@
+package kubermatic
+
+import (
+	"sync"
+	"time"
+)
+
+// metaCache is a concurrency safe cache for API lookups which rarely change
+// during a terraform run, like datacenters, projects and versions. Values
+// expire after ttl, a zero ttl disables caching.
+type metaCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*metaCacheEntry
+}
+
+type metaCacheEntry struct {
+	// mu serializes fetches of the same key, so parallel
+	// lookups result in a single API call
+	mu      sync.Mutex
+	value   interface{}
+	expires time.Time
+}
+
+func newMetaCache(ttl time.Duration) *metaCache {
+	return &metaCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]*metaCacheEntry),
+	}
+}
+
+// get returns the cached value of the key or calls fetch and caches its
+// result. Errors are never cached.
+func (c *metaCache) get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if c == nil || c.ttl <= 0 {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if !ok {
+		e = &metaCacheEntry{}
+		c.entries[key] = e
+	}
+	c.mu.Unlock()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if c.now().Before(e.expires) {
+		return e.value, nil
+	}
+
+	v, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	e.value = v
+	e.expires = c.now().Add(c.ttl)
+	return v, nil
+}
+
+// invalidate removes the key from the cache, the next get fetches it again.
+func (c *metaCache) invalidate(key string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+func datacentersCacheKey() string {
+	return "datacenters"
+}
+
+func projectCacheKey(projectID string) string {
+	return "project/" + projectID
+}
+
+func masterVersionsCacheKey(versionType string) string {
+	return "versions/master/" + versionType
+}
+
+func nodeUpgradesCacheKey(versionType, controlPlaneVersion string) string {
+	return "versions/node/" + versionType + "/" + controlPlaneVersion
+}
@@ -0,0 +1,104 @@
+package kubermatic
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMetaCache(t *testing.T) {
+	now := time.Now()
+	c := newMetaCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		v, err := c.get("key", fetch)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if v.(int) != 1 {
+			t.Fatalf("Unexpected value: want 1, got %v", v)
+		}
+	}
+
+	now = now.Add(time.Minute)
+	if v, _ := c.get("key", fetch); v.(int) != 2 {
+		t.Fatalf("Unexpected value after expiration: want 2, got %v", v)
+	}
+
+	c.invalidate("key")
+	if v, _ := c.get("key", fetch); v.(int) != 3 {
+		t.Fatalf("Unexpected value after invalidation: want 3, got %v", v)
+	}
+
+	if v, _ := c.get("other", fetch); v.(int) != 4 {
+		t.Fatalf("Unexpected value for other key: want 4, got %v", v)
+	}
+}
+
+func TestMetaCacheDoesNotCacheErrors(t *testing.T) {
+	c := newMetaCache(time.Minute)
+
+	calls := 0
+	_, err := c.get("key", func() (interface{}, error) {
+		calls++
+		return nil, errors.New("failed")
+	})
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+
+	v, err := c.get("key", func() (interface{}, error) {
+		calls++
+		return "value", nil
+	})
+	if err != nil || v.(string) != "value" || calls != 2 {
+		t.Fatalf("Unexpected result: value %v, error %v, calls %d", v, err, calls)
+	}
+}
+
+func TestMetaCacheDisabled(t *testing.T) {
+	for _, c := range []*metaCache{newMetaCache(0), nil} {
+		calls := 0
+		for i := 0; i < 3; i++ {
+			_, _ = c.get("key", func() (interface{}, error) {
+				calls++
+				return calls, nil
+			})
+		}
+		if calls != 3 {
+			t.Fatalf("Unexpected number of fetches: want 3, got %d", calls)
+		}
+	}
+}
+
+func TestMetaCacheConcurrentFetches(t *testing.T) {
+	c := newMetaCache(time.Minute)
+
+	var calls int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = c.get("key", func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				time.Sleep(10 * time.Millisecond)
+				return "value", nil
+			})
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("Unexpected number of fetches: want 1, got %d", calls)
+	}
+}
@@ -0,0 +1,69 @@
+package kubermatic
+
+import (
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+// cloudProvider describes a cloud provider supported by both cluster and
+// node deployment resources. The name is the key of the provider block in
+// both the cluster cloud spec and the node deployment template cloud spec.
+type cloudProvider struct {
+	name string
+	// inUse reports whether the cluster cloud spec returned by the API is
+	// configured for this provider.
+	inUse func(*models.CloudSpec) bool
+}
+
+// cloudProviders is the single registry of supported cloud providers. Adding
+// a provider here makes it known to cluster and node deployment validation,
+// its schema blocks, expanders and flatteners still need to be added.
+var cloudProviders = []cloudProvider{
+	{
+		name:  "bringyourown",
+		inUse: func(c *models.CloudSpec) bool { return c.Bringyourown != nil },
+	},
+	{
+		name:  "aws",
+		inUse: func(c *models.CloudSpec) bool { return c.Aws != nil },
+	},
+	{
+		name:  "openstack",
+		inUse: func(c *models.CloudSpec) bool { return c.Openstack != nil },
+	},
+	{
+		name:  "azure",
+		inUse: func(c *models.CloudSpec) bool { return c.Azure != nil },
+	},
+	{
+		name:  "gcp",
+		inUse: func(c *models.CloudSpec) bool { return c.Gcp != nil },
+	},
+	{
+		name:  "vsphere",
+		inUse: func(c *models.CloudSpec) bool { return c.Vsphere != nil },
+	},
+	{
+		name:  "hetzner",
+		inUse: func(c *models.CloudSpec) bool { return c.Hetzner != nil },
+	},
+	{
+		name:  "digitalocean",
+		inUse: func(c *models.CloudSpec) bool { return c.Digitalocean != nil },
+	},
+	{
+		name:  "packet",
+		inUse: func(c *models.CloudSpec) bool { return c.Packet != nil },
+	},
+	{
+		name:  "kubevirt",
+		inUse: func(c *models.CloudSpec) bool { return c.Kubevirt != nil },
+	},
+}
+
+func cloudProviderNames() []string {
+	names := make([]string, len(cloudProviders))
+	for i, p := range cloudProviders {
+		names[i] = p.name
+	}
+	return names
+}
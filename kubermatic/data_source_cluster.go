@@ -107,9 +107,15 @@ func dataSourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	values := clusterPreserveValues{
-		openstack: &clusterOpenstackPreservedValues{},
-		azure:     &models.AzureCloudSpec{},
-		aws:       &models.AWSCloudSpec{},
+		openstack:    &clusterOpenstackPreservedValues{},
+		azure:        &models.AzureCloudSpec{},
+		aws:          &models.AWSCloudSpec{},
+		gcp:          &models.GCPCloudSpec{},
+		vsphere:      &models.VSphereCloudSpec{},
+		hetzner:      &models.HetznerCloudSpec{},
+		digitalocean: &models.DigitaloceanCloudSpec{},
+		packet:       &models.PacketCloudSpec{},
+		kubevirt:     &models.KubevirtCloudSpec{},
 	}
 	specFlattenned := flattenClusterSpec(values, r.Payload.Spec)
 	if err = d.Set("spec", specFlattenned); err != nil {
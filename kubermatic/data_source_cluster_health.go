@@ -0,0 +1,87 @@
+package kubermatic
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+func dataSourceClusterHealth() *schema.Resource {
+	fields := clusterHealthFields()
+	fields["project_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Reference project identifier",
+	}
+	fields["dc_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Data center name",
+	}
+	fields["cluster_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Reference cluster identifier",
+	}
+	fields["ready_components"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Components that have to be up for the cluster to be ready, defaults to all but gatekeeper and MLA components",
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(clusterHealthComponentNames(), false),
+		},
+	}
+	fields["ready"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether all ready components are up",
+	}
+	fields["not_ready_components"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Ready components which are not up, with their status",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceClusterHealthRead,
+		Schema: fields,
+	}
+}
+
+func dataSourceClusterHealthRead(d *schema.ResourceData, m interface{}) error {
+	k := m.(*kubermaticProviderMeta)
+	projectID := d.Get("project_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+	dc, err := getDatacenterByName(k, d.Get("dc_name").(string))
+	if err != nil {
+		return err
+	}
+
+	health, err := getClusterHealth(k, projectID, dc.Spec.Seed, clusterID)
+	if err != nil {
+		return err
+	}
+	if health == nil {
+		// no health reported yet, report all components as down
+		health = &models.ClusterHealth{}
+	}
+
+	for key, value := range flattenClusterHealth(health)[0].(map[string]interface{}) {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	notReady := clusterComponentsNotReady(health, clusterReadyComponents(d))
+	d.Set("ready", len(notReady) == 0)
+	if err := d.Set("not_ready_components", notReady); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", projectID, dc.Spec.Seed, clusterID))
+	return nil
+}
@@ -0,0 +1,30 @@
+package kubermatic
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKubermaticClusterHealthDataSource_UnknownDC(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubermaticClusterHealthDataSourceConfig,
+				ExpectError: regexp.MustCompile(`Datacenter 'yyyyyyyy' not found`),
+			},
+		},
+	})
+}
+
+const testAccKubermaticClusterHealthDataSourceConfig = `
+data "kubermatic_cluster_health" "acctest_cluster" {
+  project_id       = "xxxxxxxx"
+  dc_name          = "yyyyyyyy"
+  cluster_id       = "zzzzzzzz"
+  ready_components = ["apiserver", "etcd"]
+}
+`
@@ -21,31 +21,136 @@ func dataSourceClusterKubeconfigV2() *schema.Resource {
 				Required:    true,
 				Description: "Reference cluster identifier",
 			},
+			"dc_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Data center name, used to look up the seed the cluster lives on",
+			},
+			"oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Request an OIDC kubeconfig instead of the admin one, requires dc_name",
+			},
 			"kubeconfig": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Kubeconfig of the given cluster.",
 			},
+			"context_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the kubeconfig context used for the other attributes",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Address of the cluster API server",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM encoded root certificate of the cluster",
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM encoded client certificate used to authenticate against the cluster",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded client key used to authenticate against the cluster",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token used to authenticate against the cluster",
+			},
 		},
 	}
 }
 
 func dataSourceClusterKubeconfigV2Read(d *schema.ResourceData, meta interface{}) error {
 	k := meta.(*kubermaticProviderMeta)
-	p := project.NewGetClusterKubeconfigV2Params()
-
+	projectID := d.Get("project_id").(string)
 	clusterID := d.Get("cluster_id").(string)
-	p.SetProjectID(d.Get("project_id").(string))
-	p.SetClusterID(clusterID)
+	dcName := d.Get("dc_name").(string)
+	oidc := d.Get("oidc").(bool)
+
+	if oidc && dcName == "" {
+		return fmt.Errorf("dc_name must be set to get OIDC kubeconfig")
+	}
+
+	var (
+		kubeconfig []byte
+		err        error
+	)
+	if dcName != "" {
+		kubeconfig, err = getClusterKubeconfigFromSeed(k, projectID, dcName, clusterID, oidc)
+	} else {
+		p := project.NewGetClusterKubeconfigV2Params()
+		p.SetProjectID(projectID)
+		p.SetClusterID(clusterID)
 
-	r, err := k.client.Project.GetClusterKubeconfigV2(p, k.auth)
+		var r *project.GetClusterKubeconfigV2OK
+		r, err = k.client.Project.GetClusterKubeconfigV2(p, k.auth)
+		if r != nil {
+			kubeconfig = r.Payload
+		}
+	}
 	if err != nil {
-		return fmt.Errorf("unable to get cluster kubeconfig '%s': %s", clusterID, err)
+		return fmt.Errorf("unable to get cluster kubeconfig '%s': %v", clusterID, err)
+	}
+
+	parsed, err := flattenKubeConfig(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("unable to parse cluster kubeconfig '%s': %v", clusterID, err)
 	}
 
 	// Set data variables
-	d.Set("kubeconfig", string(r.Payload))
+	d.Set("kubeconfig", string(kubeconfig))
+	if len(parsed) > 0 {
+		att := parsed[0].(map[string]interface{})
+		d.Set("context_name", att["context_name"])
+		d.Set("host", att["host"])
+		d.Set("cluster_ca_certificate", att["cluster_ca_certificate"])
+		d.Set("client_certificate", att["client_certificate"])
+		d.Set("client_key", att["client_key"])
+		d.Set("token", att["token"])
+	}
 
 	d.SetId(clusterID)
 	return nil
 }
+
+func getClusterKubeconfigFromSeed(k *kubermaticProviderMeta, projectID, dcName, clusterID string, oidc bool) ([]byte, error) {
+	dc, err := getDatacenterByName(k, dcName)
+	if err != nil {
+		return nil, err
+	}
+
+	if oidc {
+		p := project.NewGetOidcClusterKubeconfigParams()
+		p.SetProjectID(projectID)
+		p.SetDC(dc.Spec.Seed)
+		p.SetClusterID(clusterID)
+		r, err := k.client.Project.GetOidcClusterKubeconfig(p, k.auth)
+		if err != nil {
+			return nil, err
+		}
+		return r.Payload, nil
+	}
+
+	p := project.NewGetClusterKubeconfigParams()
+	p.SetProjectID(projectID)
+	p.SetDC(dc.Spec.Seed)
+	p.SetClusterID(clusterID)
+	r, err := k.client.Project.GetClusterKubeconfig(p, k.auth)
+	if err != nil {
+		return nil, err
+	}
+	return r.Payload, nil
+}
@@ -1,8 +1,10 @@
 package kubermatic
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"regexp"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 )
 
 func TestAccKubermaticClusterKubeconfigDataSource(t *testing.T) {
@@ -18,6 +20,10 @@ func TestAccKubermaticClusterKubeconfigDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "cluster_id", "yyyyyyyy"),
 				),
 			},
+			{
+				Config:      testAccKubermaticClusterKubeconfigDataSourceOIDCConfig,
+				ExpectError: regexp.MustCompile(`dc_name must be set to get OIDC kubeconfig`),
+			},
 		},
 	})
 }
@@ -28,3 +34,11 @@ data "kubermatic_cluster_kubeconfig" "acctest_cluster" {
   cluster_id = "yyyyyyyy"
 }
 `
+
+const testAccKubermaticClusterKubeconfigDataSourceOIDCConfig = `
+data "kubermatic_cluster_kubeconfig" "acctest_cluster" {
+  project_id = "xxxxxxxx"
+  cluster_id = "yyyyyyyy"
+  oidc       = true
+}
+`
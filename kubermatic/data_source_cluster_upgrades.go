@@ -0,0 +1,121 @@
+package kubermatic
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kubermatic/go-kubermatic/client/project"
+)
+
+func dataSourceClusterUpgrades() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClusterUpgradesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Reference project identifier",
+			},
+			"dc_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Data center name",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Reference cluster identifier",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current version of the cluster",
+			},
+			"upgrades": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Versions the cluster can be upgraded to, sorted from the oldest to the newest. Versions restricted by the kubelet versions of the nodes are left out",
+				Elem:        masterVersionSchema(),
+			},
+			"node_deployment_upgrades": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Kubelet versions the node deployments of the cluster can be upgraded to, sorted from the oldest to the newest. Restricted versions are left out",
+				Elem:        masterVersionSchema(),
+			},
+			"latest_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Newest version the cluster can be upgraded to",
+			},
+			"latest_patch_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Newest patch version of the current minor version the cluster can be upgraded to",
+			},
+		},
+	}
+}
+
+func masterVersionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version",
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the version is the default one",
+			},
+		},
+	}
+}
+
+func dataSourceClusterUpgradesRead(d *schema.ResourceData, m interface{}) error {
+	k := m.(*kubermaticProviderMeta)
+	projectID := d.Get("project_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+	dc, err := getDatacenterByName(k, d.Get("dc_name").(string))
+	if err != nil {
+		return err
+	}
+
+	p := project.NewGetClusterParams()
+	p.SetProjectID(projectID)
+	p.SetDC(dc.Spec.Seed)
+	p.SetClusterID(clusterID)
+	r, err := k.client.Project.GetCluster(p, k.auth)
+	if err != nil {
+		return fmt.Errorf("unable to get cluster '%s': %v", clusterID, err)
+	}
+	clusterVersion := string(r.Payload.Spec.Version)
+
+	// upgrades restricted by the kubelet versions of the nodes would be rejected
+	allUpgrades, err := getClusterUpgrades(k, projectID, dc.Spec.Seed, clusterID)
+	if err != nil {
+		return err
+	}
+	upgrades := unrestrictedMasterVersions(allUpgrades)
+
+	allNodeUpgrades, err := getNodeUpgrades(k, "", clusterVersion)
+	if err != nil {
+		return err
+	}
+	nodeUpgrades := unrestrictedMasterVersions(allNodeUpgrades)
+
+	d.Set("version", clusterVersion)
+	if err := d.Set("upgrades", flattenMasterVersions(upgrades)); err != nil {
+		return err
+	}
+	if err := d.Set("node_deployment_upgrades", flattenMasterVersions(nodeUpgrades)); err != nil {
+		return err
+	}
+	d.Set("latest_version", latestMasterVersion(upgrades, ""))
+	d.Set("latest_patch_version", latestMasterVersion(upgrades, clusterVersion))
+
+	d.SetId(clusterID)
+	return nil
+}
@@ -0,0 +1,29 @@
+package kubermatic
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKubermaticClusterUpgradesDataSource_UnknownDC(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubermaticClusterUpgradesDataSourceConfig,
+				ExpectError: regexp.MustCompile(`Datacenter 'yyyyyyyy' not found`),
+			},
+		},
+	})
+}
+
+const testAccKubermaticClusterUpgradesDataSourceConfig = `
+data "kubermatic_cluster_upgrades" "acctest_cluster" {
+  project_id = "xxxxxxxx"
+  dc_name    = "yyyyyyyy"
+  cluster_id = "zzzzzzzz"
+}
+`
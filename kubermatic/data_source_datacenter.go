@@ -0,0 +1,138 @@
+package kubermatic
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDatacenter() *schema.Resource {
+	fields := datacenterFields()
+	fields["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Data center name",
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceDatacenterRead,
+		Schema: fields,
+	}
+}
+
+func datacenterFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Data center name",
+		},
+		"seed": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Seed the data center belongs to",
+		},
+		"cloud_provider": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Cloud provider of the data center",
+		},
+		"country": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Country code of the data center",
+		},
+		"location": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Location of the data center",
+		},
+		"aws": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "AWS data center details",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"region": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"images": {
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Description: "Images per operating system",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"openstack": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "OpenStack data center details",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"auth_url": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"region": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"availability_zone": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"images": {
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Description: "Images per operating system",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"azure": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Azure data center details",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"location": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"gcp": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "GCP data center details",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"region": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatacenterRead(d *schema.ResourceData, m interface{}) error {
+	k := m.(*kubermaticProviderMeta)
+	dc, err := getDatacenterByName(k, d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+
+	for key, v := range flattenDatacenter(dc) {
+		if err := d.Set(key, v); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(dc.Metadata.Name)
+	return nil
+}
@@ -0,0 +1,75 @@
+package kubermatic
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDatacenters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDatacentersRead,
+		Schema: map[string]*schema.Schema{
+			"cloud_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "List only data centers of the given cloud provider",
+			},
+			"seed": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "List only data centers of the given seed",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the matching data centers",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"datacenters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching data centers",
+				Elem: &schema.Resource{
+					Schema: datacenterFields(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatacentersRead(d *schema.ResourceData, m interface{}) error {
+	k := m.(*kubermaticProviderMeta)
+	provider := d.Get("cloud_provider").(string)
+	seed := d.Get("seed").(string)
+
+	dcs, err := listDatacenters(k)
+	if err != nil {
+		return err
+	}
+
+	var names, datacenters []interface{}
+	for _, dc := range dcs {
+		if dc == nil || dc.Metadata == nil || dc.Spec == nil {
+			continue
+		}
+		if provider != "" && dc.Spec.Provider != provider {
+			continue
+		}
+		if seed != "" && dc.Spec.Seed != seed {
+			continue
+		}
+		names = append(names, dc.Metadata.Name)
+		datacenters = append(datacenters, flattenDatacenter(dc))
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+	if err := d.Set("datacenters", datacenters); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", provider, seed))
+	return nil
+}
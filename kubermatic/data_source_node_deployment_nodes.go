@@ -0,0 +1,205 @@
+package kubermatic
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kubermatic/go-kubermatic/client/project"
+)
+
+func dataSourceNodeDeploymentNodes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNodeDeploymentNodesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Reference project identifier",
+			},
+			"dc_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Data center name",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Reference cluster identifier",
+			},
+			"node_deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Reference node deployment identifier",
+			},
+			// Computed
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Nodes of the node deployment, sorted by name. Cloud instance IDs are not included, as the API doesn't expose the provider ID of nodes",
+				Elem: &schema.Resource{
+					Schema: nodeFields(),
+				},
+			},
+			"internal_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Internal IP addresses of all nodes",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"external_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "External IP addresses of all nodes",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func nodeFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Node identifier",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Node name",
+		},
+		"machine_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the machine backing the node",
+		},
+		"addresses": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Addresses of the node",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Address type, e.g. InternalIP, ExternalIP or Hostname",
+					},
+					"address": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Address",
+					},
+				},
+			},
+		},
+		"internal_ips": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Internal IP addresses of the node",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"external_ips": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "External IP addresses of the node",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"kubelet_version": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Kubelet version",
+		},
+		"os_distribution": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Operating system distribution selected in the node spec, e.g. ubuntu, not the image reported by the kubelet",
+		},
+		"operating_system": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Operating system reported by the node, e.g. linux",
+		},
+		"kernel_version": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Kernel version",
+		},
+		"architecture": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Architecture",
+		},
+		"container_runtime": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Container runtime and its version",
+		},
+		"creation_timestamp": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Creation timestamp",
+		},
+		"deletion_timestamp": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Deletion timestamp",
+		},
+		"ready": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the node joined the cluster and the machine has no errors",
+		},
+		"error_reason": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Reason of the machine error",
+		},
+		"error_message": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Message of the machine error",
+		},
+	}
+}
+
+func dataSourceNodeDeploymentNodesRead(d *schema.ResourceData, m interface{}) error {
+	k := m.(*kubermaticProviderMeta)
+	projectID := d.Get("project_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+	nodeDeplID := d.Get("node_deployment_id").(string)
+	dc, err := getDatacenterByName(k, d.Get("dc_name").(string))
+	if err != nil {
+		return err
+	}
+
+	p := project.NewListNodeDeploymentNodesParams()
+	p.SetProjectID(projectID)
+	p.SetDC(dc.Spec.Seed)
+	p.SetClusterID(clusterID)
+	p.SetNodeDeploymentID(nodeDeplID)
+	r, err := k.client.Project.ListNodeDeploymentNodes(p, k.auth)
+	if err != nil {
+		return fmt.Errorf("unable to list nodes of node deployment '%s': %v", nodeDeplID, err)
+	}
+
+	nodes := flattenNodes(r.Payload)
+	if err := d.Set("nodes", nodes); err != nil {
+		return err
+	}
+
+	var internal, external []interface{}
+	for _, n := range nodes {
+		n := n.(map[string]interface{})
+		internal = append(internal, n["internal_ips"].([]interface{})...)
+		external = append(external, n["external_ips"].([]interface{})...)
+	}
+	if err := d.Set("internal_ips", internal); err != nil {
+		return err
+	}
+	if err := d.Set("external_ips", external); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s:%s", projectID, dc.Spec.Seed, clusterID, nodeDeplID))
+	return nil
+}
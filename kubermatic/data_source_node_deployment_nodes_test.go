@@ -0,0 +1,30 @@
+package kubermatic
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKubermaticNodeDeploymentNodesDataSource_UnknownDC(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubermaticNodeDeploymentNodesDataSourceConfig,
+				ExpectError: regexp.MustCompile(`Datacenter 'yyyyyyyy' not found`),
+			},
+		},
+	})
+}
+
+const testAccKubermaticNodeDeploymentNodesDataSourceConfig = `
+data "kubermatic_node_deployment_nodes" "acctest_nodes" {
+  project_id         = "xxxxxxxx"
+  dc_name            = "yyyyyyyy"
+  cluster_id         = "zzzzzzzz"
+  node_deployment_id = "wwwwwwww"
+}
+`
@@ -65,7 +65,7 @@ func dataSourceProjectRead(d *schema.ResourceData, m interface{}) error {
 			// get the project ID from the API
 			r, err := k.client.Project.ListProjects(p, k.auth)
 			if err != nil {
-				return fmt.Errorf("error when getting projects list: %s", getErrorResponse(err))
+				return fmt.Errorf("error when getting projects list: %v", err)
 			}
 			for _, project := range r.Payload {
 				if project.Name == p_name {
@@ -43,7 +43,7 @@ func dataSourceSSHKeyRead(d *schema.ResourceData, m interface{}) error {
 
 	r, err := k.client.Project.ListSSHKeys(p, k.auth)
 	if err != nil {
-		return fmt.Errorf("error retrieving SSH key list for the given project. Error: %s", getErrorResponse(err))
+		return fmt.Errorf("error retrieving SSH key list for the given project. Error: %v", err)
 	}
 	for _, key := range r.Payload {
 		if key.Name == ssh_name {
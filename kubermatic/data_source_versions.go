@@ -0,0 +1,137 @@
+package kubermatic
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/kubermatic/go-kubermatic/client/versions"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+func dataSourceVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVersionsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "kubernetes",
+				ValidateFunc: validation.StringInSlice([]string{"kubernetes", "openshift"}, false),
+				Description:  "Cloud orchestrator, either kubernetes or openshift",
+			},
+			"version_constraint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Version constraint the listed versions must match, e.g. \"~> 1.22.0\"",
+			},
+			"control_plane_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Control plane version to list compatible kubelet versions for",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Master versions matching the constraint, sorted from the oldest to the newest",
+				Elem:        masterVersionSchema(),
+			},
+			"default": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Default master version, empty if it does not match the constraint",
+			},
+			"latest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Newest master version matching the constraint",
+			},
+			"kubelet_versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Kubelet versions compatible with control_plane_version, sorted from the oldest to the newest",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceVersionsRead(d *schema.ResourceData, m interface{}) error {
+	k := m.(*kubermaticProviderMeta)
+	versionType := d.Get("type").(string)
+	constraint := d.Get("version_constraint").(string)
+
+	all, err := getMasterVersions(k, versionType)
+	if err != nil {
+		return err
+	}
+
+	masterVersions, err := filterMasterVersions(all, constraint)
+	if err != nil {
+		return fmt.Errorf("invalid version constraint '%s': %v", constraint, err)
+	}
+
+	if err := d.Set("versions", flattenMasterVersions(masterVersions)); err != nil {
+		return err
+	}
+	d.Set("default", defaultMasterVersion(masterVersions))
+	d.Set("latest", latestMasterVersion(masterVersions, ""))
+
+	var kubeletVersions []interface{}
+	if controlPlaneVersion := d.Get("control_plane_version").(string); controlPlaneVersion != "" {
+		upgrades, err := getNodeUpgrades(k, versionType, controlPlaneVersion)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range flattenMasterVersions(unrestrictedMasterVersions(upgrades)) {
+			kubeletVersions = append(kubeletVersions, v.(map[string]interface{})["version"])
+		}
+	}
+	if err := d.Set("kubelet_versions", kubeletVersions); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", versionType, constraint, d.Get("control_plane_version").(string)))
+	return nil
+}
+
+// getMasterVersions lists master versions of the given type, an empty type
+// lists versions of the default type.
+func getMasterVersions(k *kubermaticProviderMeta, versionType string) ([]*models.MasterVersion, error) {
+	v, err := k.cache.get(masterVersionsCacheKey(versionType), func() (interface{}, error) {
+		p := versions.NewGetMasterVersionsParams()
+		if versionType != "" {
+			p.SetType(&versionType)
+		}
+		r, err := k.client.Versions.GetMasterVersions(p, k.auth)
+		if err != nil {
+			return nil, fmt.Errorf("get master versions: %v", err)
+		}
+		return r.Payload, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*models.MasterVersion), nil
+}
+
+// getNodeUpgrades lists kubelet versions compatible with the control plane version.
+func getNodeUpgrades(k *kubermaticProviderMeta, versionType, controlPlaneVersion string) ([]*models.MasterVersion, error) {
+	v, err := k.cache.get(nodeUpgradesCacheKey(versionType, controlPlaneVersion), func() (interface{}, error) {
+		p := versions.NewGetNodeUpgradesParams()
+		if versionType != "" {
+			p.SetType(&versionType)
+		}
+		p.SetControlPlaneVersion(&controlPlaneVersion)
+		r, err := k.client.Versions.GetNodeUpgrades(p, k.auth)
+		if err != nil {
+			return nil, fmt.Errorf("get node_deployment upgrades: %v", err)
+		}
+		return r.Payload, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*models.MasterVersion), nil
+}
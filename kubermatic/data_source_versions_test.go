@@ -0,0 +1,34 @@
+package kubermatic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKubermaticVersionsDataSource(t *testing.T) {
+	name := "data.kubermatic_versions.acctest"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubermaticVersionsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "default"),
+					resource.TestCheckResourceAttrSet(name, "latest"),
+					resource.TestCheckResourceAttrSet(name, "versions.0.version"),
+					resource.TestCheckResourceAttrSet(name, "kubelet_versions.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKubermaticVersionsDataSourceConfig = `
+data "kubermatic_versions" "all" {}
+
+data "kubermatic_versions" "acctest" {
+  control_plane_version = data.kubermatic_versions.all.default
+}
+`
@@ -0,0 +1,178 @@
+package kubermatic
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+// Errors to match API errors with errors.Is.
+var (
+	errNotFound     = errors.New("not found")
+	errConflict     = errors.New("conflict")
+	errUnauthorized = errors.New("unauthorized")
+	errForbidden    = errors.New("forbidden")
+	errRateLimited  = errors.New("rate limited")
+)
+
+// apiError is an error response of the Kubermatic API.
+type apiError struct {
+	code    int
+	method  string
+	path    string
+	message string
+	// err is the error returned by the generated client
+	err error
+}
+
+func (e *apiError) Error() string {
+	s := fmt.Sprintf("%s %s: %d %s", e.method, e.path, e.code, http.StatusText(e.code))
+	switch {
+	case e.message != "":
+		s += ": " + e.message
+	case e.code == http.StatusUnauthorized:
+		s += ": invalid or expired token"
+	}
+	return s
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+func (e *apiError) Is(target error) bool {
+	switch target {
+	case errNotFound:
+		return e.code == http.StatusNotFound
+	case errConflict:
+		return e.code == http.StatusConflict
+	case errUnauthorized:
+		return e.code == http.StatusUnauthorized
+	case errForbidden:
+		return e.code == http.StatusForbidden
+	case errRateLimited:
+		return e.code == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// isResourceGone reports whether the error means the resource does not exist
+// anymore. Resources the user lost access to, e.g. because the project was
+// deleted, are gone as well.
+func isResourceGone(err error) bool {
+	return errors.Is(err, errNotFound) || errors.Is(err, errForbidden)
+}
+
+// isTransientError reports whether the request may succeed when sent again,
+// that is the API is rate limiting or failing temporarily, or the connection
+// failed.
+func isTransientError(err error) bool {
+	var e *apiError
+	if errors.As(err, &e) {
+		return e.code == http.StatusTooManyRequests || (e.code >= 500 && e.code != http.StatusNotImplemented)
+	}
+	return isRetryableError(err)
+}
+
+// apiErrorTransport translates errors of the generated client into apiError,
+// so every client call reports errors the same way.
+type apiErrorTransport struct {
+	runtime.ClientTransport
+}
+
+func (t *apiErrorTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	r, err := t.ClientTransport.Submit(op)
+	if err != nil {
+		return nil, newAPIError(op.Method, op.PathPattern, err)
+	}
+	return r, nil
+}
+
+// generatedErrorPattern matches errors of the generated client, e.g.
+// "[GET /api/v1/projects/{project_id}][403] getProjectForbidden".
+var generatedErrorPattern = regexp.MustCompile(`^\[(\S+) (\S+)\]\[(\d{3})\]`)
+
+// newAPIError converts an error of the generated client to apiError. Other
+// errors, like connection failures, are returned unchanged.
+func newAPIError(method, path string, err error) error {
+	var e *apiError
+	if errors.As(err, &e) {
+		return err
+	}
+
+	code := 0
+	if m := generatedErrorPattern.FindStringSubmatch(err.Error()); m != nil {
+		method, path = m[1], m[2]
+		code, _ = strconv.Atoi(m[3])
+	}
+
+	var message string
+	switch v := err.(type) {
+	case *runtime.APIError:
+		code = v.Code
+	case interface{ Code() int }:
+		code = v.Code()
+	}
+	if v, ok := err.(interface {
+		GetPayload() *models.ErrorResponse
+	}); ok {
+		message = errorResponseMessage(v.GetPayload())
+	}
+
+	if code == 0 {
+		code = codeFromTypeName(err)
+	}
+	if code == 0 {
+		return err
+	}
+
+	return &apiError{
+		code:    code,
+		method:  method,
+		path:    path,
+		message: message,
+		err:     err,
+	}
+}
+
+// codeFromTypeName derives the status code from the name of the generated
+// response type, e.g. *project.GetProjectForbidden.
+func codeFromTypeName(err error) int {
+	name := fmt.Sprintf("%T", err)
+	for suffix, code := range map[string]int{
+		"BadRequest":      http.StatusBadRequest,
+		"Unauthorized":    http.StatusUnauthorized,
+		"Forbidden":       http.StatusForbidden,
+		"NotFound":        http.StatusNotFound,
+		"Conflict":        http.StatusConflict,
+		"TooManyRequests": http.StatusTooManyRequests,
+	} {
+		if strings.HasSuffix(name, suffix) {
+			return code
+		}
+	}
+	return 0
+}
+
+func errorResponseMessage(e *models.ErrorResponse) string {
+	if e == nil || e.Error == nil {
+		return ""
+	}
+	var message string
+	if e.Error.Message != nil {
+		message = *e.Error.Message
+	}
+	if len(e.Error.Details) > 0 {
+		if message != "" {
+			message += ": "
+		}
+		message += strings.Join(e.Error.Details, ", ")
+	}
+	return message
+}
@@ -0,0 +1,189 @@
+package kubermatic
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+// getThingDefault, getThingForbidden and getThingUnauthorized mimic responses
+// of the generated client.
+type getThingDefault struct {
+	code    int
+	Payload *models.ErrorResponse
+}
+
+func (o *getThingDefault) Code() int { return o.code }
+
+func (o *getThingDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/things/{thing_id}][%d] getThing default  %+v", o.code, o.Payload)
+}
+
+func (o *getThingDefault) GetPayload() *models.ErrorResponse { return o.Payload }
+
+type getThingForbidden struct{}
+
+func (o *getThingForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/v1/things/{thing_id}][%d] getThingForbidden ", 403)
+}
+
+type getThingUnauthorized struct{}
+
+func (o *getThingUnauthorized) Error() string { return "unauthorized" }
+
+type fakeClientTransport struct {
+	err error
+}
+
+func (t *fakeClientTransport) Submit(*runtime.ClientOperation) (interface{}, error) {
+	return nil, t.err
+}
+
+func errorPayload(message string, details ...string) *models.ErrorResponse {
+	return &models.ErrorResponse{
+		Error: &models.ErrorDetails{
+			Message: &message,
+			Details: details,
+		},
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	connErr := errors.New("connection refused")
+
+	cases := []struct {
+		Name        string
+		Err         error
+		ExpectError string
+		ExpectIs    []error
+		ExpectNotIs []error
+	}{
+		{
+			Name:        "not found",
+			Err:         &getThingDefault{code: http.StatusNotFound, Payload: errorPayload("thing not found")},
+			ExpectError: "GET /api/v1/things/{thing_id}: 404 Not Found: thing not found",
+			ExpectIs:    []error{errNotFound},
+			ExpectNotIs: []error{errConflict, errForbidden},
+		},
+		{
+			Name:        "no userInfo outside of get cluster",
+			Err:         &getThingDefault{code: http.StatusInternalServerError, Payload: errorPayload("no userInfo in request")},
+			ExpectError: "GET /api/v1/things/{thing_id}: 500 Internal Server Error: no userInfo in request",
+			ExpectNotIs: []error{errNotFound},
+		},
+		{
+			Name:        "conflict with details",
+			Err:         &getThingDefault{code: http.StatusConflict, Payload: errorPayload("conflict", "object was modified")},
+			ExpectError: "GET /api/v1/things/{thing_id}: 409 Conflict: conflict: object was modified",
+			ExpectIs:    []error{errConflict},
+			ExpectNotIs: []error{errNotFound},
+		},
+		{
+			Name:        "rate limited",
+			Err:         &getThingDefault{code: http.StatusTooManyRequests},
+			ExpectError: "GET /api/v1/things/{thing_id}: 429 Too Many Requests",
+			ExpectIs:    []error{errRateLimited},
+		},
+		{
+			Name:        "forbidden",
+			Err:         &getThingForbidden{},
+			ExpectError: "GET /api/v1/things/{thing_id}: 403 Forbidden",
+			ExpectIs:    []error{errForbidden},
+			ExpectNotIs: []error{errUnauthorized},
+		},
+		{
+			Name:        "unauthorized",
+			Err:         &getThingUnauthorized{},
+			ExpectError: "GET /things/{thing_id}: 401 Unauthorized: invalid or expired token",
+			ExpectIs:    []error{errUnauthorized},
+		},
+		{
+			Name:        "unexpected response",
+			Err:         runtime.NewAPIError("unknown error", nil, http.StatusBadGateway),
+			ExpectError: "GET /things/{thing_id}: 502 Bad Gateway",
+			ExpectNotIs: []error{errNotFound},
+		},
+		{
+			Name:        "connection error",
+			Err:         connErr,
+			ExpectError: "connection refused",
+			ExpectIs:    []error{connErr},
+			ExpectNotIs: []error{errNotFound},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tr := &apiErrorTransport{&fakeClientTransport{err: tc.Err}}
+			_, err := tr.Submit(&runtime.ClientOperation{Method: http.MethodGet, PathPattern: "/things/{thing_id}"})
+			if err == nil {
+				t.Fatal("Expected error, got none")
+			}
+			if err.Error() != tc.ExpectError {
+				t.Errorf("Want error '%s', got '%s'", tc.ExpectError, err)
+			}
+			for _, target := range tc.ExpectIs {
+				if !errors.Is(err, target) {
+					t.Errorf("Want error to be '%v'", target)
+				}
+			}
+			for _, target := range tc.ExpectNotIs {
+				if errors.Is(err, target) {
+					t.Errorf("Want error not to be '%v'", target)
+				}
+			}
+			if !errors.Is(err, tc.Err) {
+				t.Error("Want error to wrap the client error")
+			}
+		})
+	}
+}
+
+func TestIsResourceGone(t *testing.T) {
+	cases := []struct {
+		Err      error
+		Expected bool
+	}{
+		{Err: &apiError{code: http.StatusNotFound}, Expected: true},
+		{Err: &apiError{code: http.StatusForbidden}, Expected: true},
+		{Err: fmt.Errorf("get cluster: %w", &apiError{code: http.StatusNotFound}), Expected: true},
+		{Err: &apiError{code: http.StatusUnauthorized}, Expected: false},
+		{Err: &apiError{code: http.StatusInternalServerError}, Expected: false},
+		{Err: errors.New("connection refused"), Expected: false},
+	}
+
+	for _, tc := range cases {
+		if got := isResourceGone(tc.Err); got != tc.Expected {
+			t.Errorf("%v: want %v, got %v", tc.Err, tc.Expected, got)
+		}
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	cases := []struct {
+		Err      error
+		Expected bool
+	}{
+		{Err: &apiError{code: http.StatusTooManyRequests}, Expected: true},
+		{Err: &apiError{code: http.StatusServiceUnavailable}, Expected: true},
+		{Err: fmt.Errorf("list: %w", &apiError{code: http.StatusBadGateway}), Expected: true},
+		{Err: &apiError{code: http.StatusNotImplemented}, Expected: false},
+		{Err: &apiError{code: http.StatusForbidden}, Expected: false},
+		{Err: &apiError{code: http.StatusNotFound}, Expected: false},
+		{Err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, Expected: true},
+		{Err: errors.New("invalid spec"), Expected: false},
+	}
+
+	for _, tc := range cases {
+		if got := isTransientError(tc.Err); got != tc.Expected {
+			t.Errorf("%v: want %v, got %v", tc.Err, tc.Expected, got)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package kubermatic
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// createMergePatch returns a JSON merge patch (RFC 7386) that turns original
+// into modified, or nil if both are equal. Fields removed in modified are set
+// to null, arrays are replaced as a whole.
+func createMergePatch(original, modified interface{}) (map[string]interface{}, error) {
+	o, err := toJSONObject(original)
+	if err != nil {
+		return nil, err
+	}
+	m, err := toJSONObject(modified)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := diffJSONObjects(o, m)
+	if len(patch) == 0 {
+		return nil, nil
+	}
+	return patch, nil
+}
+
+func toJSONObject(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		obj = make(map[string]interface{})
+	}
+	return obj, nil
+}
+
+func diffJSONObjects(original, modified map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{})
+	for k, mv := range modified {
+		ov, ok := original[k]
+		if !ok {
+			patch[k] = mv
+			continue
+		}
+		om, oIsObject := ov.(map[string]interface{})
+		mm, mIsObject := mv.(map[string]interface{})
+		if oIsObject && mIsObject {
+			if p := diffJSONObjects(om, mm); len(p) > 0 {
+				patch[k] = p
+			}
+			continue
+		}
+		if !reflect.DeepEqual(ov, mv) {
+			patch[k] = mv
+		}
+	}
+	for k := range original {
+		if _, ok := modified[k]; !ok {
+			patch[k] = nil
+		}
+	}
+	return patch
+}
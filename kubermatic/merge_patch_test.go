@@ -0,0 +1,78 @@
+package kubermatic
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreateMergePatch(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Original interface{}
+		Modified interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "equal",
+			Original: map[string]interface{}{"replicas": 1, "template": map[string]interface{}{"image": "a"}},
+			Modified: map[string]interface{}{"replicas": 1, "template": map[string]interface{}{"image": "a"}},
+		},
+		{
+			Name:     "scalar changed",
+			Original: map[string]interface{}{"replicas": 1, "template": map[string]interface{}{"image": "a"}},
+			Modified: map[string]interface{}{"replicas": 3, "template": map[string]interface{}{"image": "a"}},
+			Expected: map[string]interface{}{"replicas": float64(3)},
+		},
+		{
+			Name: "nested changed",
+			Original: map[string]interface{}{
+				"template": map[string]interface{}{"image": "a", "flavor": "m1.small"},
+			},
+			Modified: map[string]interface{}{
+				"template": map[string]interface{}{"image": "b", "flavor": "m1.small"},
+			},
+			Expected: map[string]interface{}{
+				"template": map[string]interface{}{"image": "b"},
+			},
+		},
+		{
+			Name:     "field added and removed",
+			Original: map[string]interface{}{"labels": map[string]interface{}{"a": "1"}},
+			Modified: map[string]interface{}{"labels": map[string]interface{}{"b": "2"}, "paused": true},
+			Expected: map[string]interface{}{
+				"labels": map[string]interface{}{"a": nil, "b": "2"},
+				"paused": true,
+			},
+		},
+		{
+			Name:     "array replaced",
+			Original: map[string]interface{}{"taints": []interface{}{"a", "b"}},
+			Modified: map[string]interface{}{"taints": []interface{}{"a"}},
+			Expected: map[string]interface{}{"taints": []interface{}{"a"}},
+		},
+		{
+			Name:     "object replaced by scalar",
+			Original: map[string]interface{}{"cloud": map[string]interface{}{"aws": "x"}},
+			Modified: map[string]interface{}{"cloud": nil},
+			Expected: map[string]interface{}{"cloud": nil},
+		},
+		{
+			Name:     "nil original",
+			Modified: map[string]interface{}{"replicas": 1},
+			Expected: map[string]interface{}{"replicas": float64(1)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := createMergePatch(tc.Original, tc.Modified)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.Expected, got); diff != "" {
+				t.Fatalf("Unexpected patch: mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
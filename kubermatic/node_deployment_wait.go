@@ -0,0 +1,264 @@
+package kubermatic
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/kubermatic/go-kubermatic/client/project"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+const (
+	// machineErrorInvalidConfiguration is set by the machine controller for
+	// machines that can't be created without changing their spec
+	machineErrorInvalidConfiguration = "InvalidConfiguration"
+	// maxReportedEvents limits the number of warning events shown while waiting
+	maxReportedEvents = 3
+)
+
+// terminalMachineErrorMessages are parts of machine error messages, that won't
+// be resolved by waiting, e.g. an exceeded quota or an invalid instance flavor.
+var terminalMachineErrorMessages = []string{
+	"quota",
+	"limitexceeded",
+	"limit exceeded",
+	"invalid flavor",
+	"flavor not found",
+	"invalid instance type",
+}
+
+// waitForNodeDeploymentReady waits until all replicas of the node deployment
+// are updated and ready, and machines removed on scale-down are gone. While
+// waiting, machines that are not ready and recent warning events are reported.
+// Terminal machine errors fail the wait without waiting for the timeout.
+// After an update, generation is the generation the controller has to have
+// observed, so replicas of the previous template are not taken as rolled out.
+// It is the generation observed before the update plus one, as the API
+// doesn't report the generation of the node deployment itself.
+func waitForNodeDeploymentReady(k *kubermaticProviderMeta, timeout time.Duration, projectID, seedDC, clusterID, id string, generation int64) error {
+	var lastProgress string
+	return resource.Retry(timeout, func() *resource.RetryError {
+		p := project.NewGetNodeDeploymentParams()
+		p.SetProjectID(projectID)
+		p.SetClusterID(clusterID)
+		p.SetDC(seedDC)
+		p.SetNodeDeploymentID(id)
+
+		r, err := k.client.Project.GetNodeDeployment(p, k.auth)
+		if err != nil {
+			if isResourceGone(err) {
+				return resource.NonRetryableError(fmt.Errorf("node deployment '%s' has been deleted while waiting for it to be ready: %v", id, err))
+			}
+			return resource.RetryableError(fmt.Errorf("unable to get node deployment '%s': %v", id, err))
+		}
+
+		observed := nodeDeploymentObservedGeneration(r.Payload) >= generation
+		if nodeDeploymentRolledOut(r.Payload, generation) {
+			return nil
+		}
+
+		nodes, events := getNodeDeploymentNodesAndEvents(k, projectID, seedDC, clusterID, id)
+		if err := terminalMachineError(nodes); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("node deployment '%s' will not become ready: %v", id, err))
+		}
+
+		progress := nodeDeploymentProgress(r.Payload, nodes, events)
+		if !observed {
+			progress = "update not observed by the controller yet; " + progress
+		}
+		if progress != lastProgress {
+			k.log.Infof("waiting for node deployment '%s': %s", id, progress)
+			lastProgress = progress
+		}
+		return resource.RetryableError(fmt.Errorf("waiting for node deployment '%s' to be ready: %s", id, progress))
+	})
+}
+
+// nodeDeploymentReady returns true if the number of existing, updated and
+// ready replicas matches the desired replicas.
+func nodeDeploymentReady(nd *models.NodeDeployment) bool {
+	if nd.Spec == nil || nd.Spec.Replicas == nil {
+		return false
+	}
+	desired := *nd.Spec.Replicas
+	if nd.Status == nil {
+		return desired == 0
+	}
+	s := nd.Status
+	return s.Replicas == desired && s.UpdatedReplicas == desired && s.ReadyReplicas == desired
+}
+
+// nodeDeploymentRolledOut returns true if the controller observed at least
+// generation and all desired replicas are updated to it and ready.
+func nodeDeploymentRolledOut(nd *models.NodeDeployment, generation int64) bool {
+	return nodeDeploymentObservedGeneration(nd) >= generation && nodeDeploymentReady(nd)
+}
+
+func nodeDeploymentObservedGeneration(nd *models.NodeDeployment) int64 {
+	if nd == nil || nd.Status == nil {
+		return 0
+	}
+	return nd.Status.ObservedGeneration
+}
+
+// nodeDeploymentStatus returns the readiness of the node deployment reported in
+// the status attribute.
+func nodeDeploymentStatus(nd *models.NodeDeployment) string {
+	if nodeDeploymentReady(nd) {
+		return statusReady
+	}
+	return statusNotReady
+}
+
+// getNodeDeploymentNodesAndEvents fetches nodes and warning events of the node
+// deployment in parallel. Both are used for reporting only, so errors are logged
+// and not returned.
+func getNodeDeploymentNodesAndEvents(k *kubermaticProviderMeta, projectID, seedDC, clusterID, id string) ([]*models.Node, []*models.Event) {
+	var (
+		wg     sync.WaitGroup
+		nodes  []*models.Node
+		events []*models.Event
+	)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		p := project.NewListNodeDeploymentNodesParams()
+		p.SetProjectID(projectID)
+		p.SetDC(seedDC)
+		p.SetClusterID(clusterID)
+		p.SetNodeDeploymentID(id)
+		r, err := k.client.Project.ListNodeDeploymentNodes(p, k.auth)
+		if err != nil {
+			k.log.Debugf("unable to list nodes of node deployment '%s': %v", id, err)
+			return
+		}
+		nodes = r.Payload
+	}()
+	go func() {
+		defer wg.Done()
+		eventType := "warning"
+		p := project.NewListNodeDeploymentNodesEventsParams()
+		p.SetProjectID(projectID)
+		p.SetDC(seedDC)
+		p.SetClusterID(clusterID)
+		p.SetNodeDeploymentID(id)
+		p.SetType(&eventType)
+		r, err := k.client.Project.ListNodeDeploymentNodesEvents(p, k.auth)
+		if err != nil {
+			k.log.Debugf("unable to list events of node deployment '%s': %v", id, err)
+			return
+		}
+		events = r.Payload
+	}()
+	wg.Wait()
+
+	return nodes, events
+}
+
+// terminalMachineError returns an error for the first machine failed with an
+// error that won't be resolved by waiting.
+func terminalMachineError(nodes []*models.Node) error {
+	for _, n := range nodes {
+		if n == nil || n.Status == nil || n.Status.ErrorReason == "" {
+			continue
+		}
+		if n.Status.ErrorReason == machineErrorInvalidConfiguration || isTerminalMachineErrorMessage(n.Status.ErrorMessage) {
+			return fmt.Errorf("machine '%s': %s: %s", machineName(n), n.Status.ErrorReason, n.Status.ErrorMessage)
+		}
+	}
+	return nil
+}
+
+func isTerminalMachineErrorMessage(msg string) bool {
+	msg = strings.ToLower(msg)
+	for _, s := range terminalMachineErrorMessages {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeDeploymentProgress describes the replicas of the node deployment, the
+// machines that are not ready and the most recent warning events.
+func nodeDeploymentProgress(nd *models.NodeDeployment, nodes []*models.Node, events []*models.Event) string {
+	var desired int32
+	if nd.Spec != nil && nd.Spec.Replicas != nil {
+		desired = *nd.Spec.Replicas
+	}
+	s := nd.Status
+	if s == nil {
+		s = &models.MachineDeploymentStatus{}
+	}
+
+	parts := []string{
+		fmt.Sprintf("%d/%d replicas ready, %d updated", s.ReadyReplicas, desired, s.UpdatedReplicas),
+	}
+	if s.Replicas > desired {
+		parts = append(parts, fmt.Sprintf("scaling down, %d machines to be removed", s.Replicas-desired))
+	}
+
+	var pending []string
+	for _, n := range nodes {
+		if n == nil {
+			continue
+		}
+		switch {
+		case n.Status != nil && n.Status.ErrorReason != "":
+			pending = append(pending, fmt.Sprintf("machine '%s': %s: %s", machineName(n), n.Status.ErrorReason, n.Status.ErrorMessage))
+		case n.Status == nil || n.Status.NodeInfo == nil || n.Status.NodeInfo.KubeletVersion == "":
+			pending = append(pending, fmt.Sprintf("machine '%s': node has not joined the cluster yet", machineName(n)))
+		}
+	}
+	sort.Strings(pending)
+	parts = append(parts, pending...)
+
+	for _, e := range recentEvents(events, maxReportedEvents) {
+		var object string
+		if e.InvolvedObject != nil {
+			object = e.InvolvedObject.Name
+		}
+		parts = append(parts, fmt.Sprintf("event %s '%s': %s", e.Reason, object, e.Message))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// recentEvents returns up to n of the most recent events with distinct messages.
+func recentEvents(events []*models.Event, n int) []*models.Event {
+	sorted := make([]*models.Event, 0, len(events))
+	for _, e := range events {
+		if e != nil {
+			sorted = append(sorted, e)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return time.Time(sorted[i].LastTimestamp).After(time.Time(sorted[j].LastTimestamp))
+	})
+
+	var ret []*models.Event
+	seen := make(map[string]bool)
+	for _, e := range sorted {
+		if len(ret) == n {
+			break
+		}
+		if seen[e.Message] {
+			continue
+		}
+		seen[e.Message] = true
+		ret = append(ret, e)
+	}
+	return ret
+}
+
+func machineName(n *models.Node) string {
+	if n.Status != nil && n.Status.MachineName != "" {
+		return n.Status.MachineName
+	}
+	return n.Name
+}
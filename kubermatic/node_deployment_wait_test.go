@@ -0,0 +1,246 @@
+package kubermatic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+func testNodeDeployment(desired, replicas, updated, ready int32) *models.NodeDeployment {
+	return &models.NodeDeployment{
+		Spec: &models.NodeDeploymentSpec{
+			Replicas: int32ToPtr(desired),
+		},
+		Status: &models.MachineDeploymentStatus{
+			Replicas:        replicas,
+			UpdatedReplicas: updated,
+			ReadyReplicas:   ready,
+		},
+	}
+}
+
+func TestNodeDeploymentReady(t *testing.T) {
+	cases := []struct {
+		Name           string
+		NodeDeployment *models.NodeDeployment
+		Expected       bool
+	}{
+		{
+			Name:           "ready",
+			NodeDeployment: testNodeDeployment(3, 3, 3, 3),
+			Expected:       true,
+		},
+		{
+			Name:           "scaling up",
+			NodeDeployment: testNodeDeployment(3, 3, 3, 1),
+			Expected:       false,
+		},
+		{
+			Name:           "scaling down",
+			NodeDeployment: testNodeDeployment(1, 3, 3, 3),
+			Expected:       false,
+		},
+		{
+			Name:           "rolling out",
+			NodeDeployment: testNodeDeployment(2, 2, 1, 2),
+			Expected:       false,
+		},
+		{
+			Name:           "scaled to zero",
+			NodeDeployment: testNodeDeployment(0, 0, 0, 0),
+			Expected:       true,
+		},
+		{
+			Name:           "no status",
+			NodeDeployment: &models.NodeDeployment{Spec: &models.NodeDeploymentSpec{Replicas: int32ToPtr(1)}},
+			Expected:       false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := nodeDeploymentReady(tc.NodeDeployment); got != tc.Expected {
+				t.Errorf("Want %v, got %v", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestNodeDeploymentRolledOut(t *testing.T) {
+	withObservedGeneration := func(nd *models.NodeDeployment, generation int64) *models.NodeDeployment {
+		nd.Status.ObservedGeneration = generation
+		return nd
+	}
+
+	cases := []struct {
+		Name           string
+		NodeDeployment *models.NodeDeployment
+		Generation     int64
+		Expected       bool
+	}{
+		{
+			Name:           "rolled out",
+			NodeDeployment: withObservedGeneration(testNodeDeployment(2, 2, 2, 2), 3),
+			Generation:     3,
+			Expected:       true,
+		},
+		{
+			Name:           "later generation observed",
+			NodeDeployment: withObservedGeneration(testNodeDeployment(2, 2, 2, 2), 4),
+			Generation:     3,
+			Expected:       true,
+		},
+		{
+			Name:           "update not observed",
+			NodeDeployment: withObservedGeneration(testNodeDeployment(2, 2, 2, 2), 2),
+			Generation:     3,
+			Expected:       false,
+		},
+		{
+			Name:           "replicas not updated",
+			NodeDeployment: withObservedGeneration(testNodeDeployment(2, 2, 0, 2), 3),
+			Generation:     3,
+			Expected:       false,
+		},
+		{
+			Name:           "no update",
+			NodeDeployment: testNodeDeployment(2, 2, 2, 2),
+			Expected:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := nodeDeploymentRolledOut(tc.NodeDeployment, tc.Generation); got != tc.Expected {
+				t.Errorf("Want %v, got %v", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestNodeDeploymentStatus(t *testing.T) {
+	if got := nodeDeploymentStatus(testNodeDeployment(2, 2, 2, 2)); got != statusReady {
+		t.Errorf("Want %s, got %s", statusReady, got)
+	}
+	if got := nodeDeploymentStatus(testNodeDeployment(2, 2, 2, 1)); got != statusNotReady {
+		t.Errorf("Want %s, got %s", statusNotReady, got)
+	}
+}
+
+func TestTerminalMachineError(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Nodes       []*models.Node
+		ExpectError string
+	}{
+		{
+			Name: "no errors",
+			Nodes: []*models.Node{
+				{Name: "node-1", Status: &models.NodeStatus{MachineName: "machine-1"}},
+				{Name: "node-2"},
+			},
+		},
+		{
+			Name: "transient error",
+			Nodes: []*models.Node{
+				{Status: &models.NodeStatus{MachineName: "machine-1", ErrorReason: "CreateMachineError", ErrorMessage: "connection reset by peer"}},
+			},
+		},
+		{
+			Name: "invalid configuration",
+			Nodes: []*models.Node{
+				{Status: &models.NodeStatus{MachineName: "machine-1", ErrorReason: "InvalidConfiguration", ErrorMessage: "invalid flavor 'm1.huge'"}},
+			},
+			ExpectError: "machine 'machine-1': InvalidConfiguration: invalid flavor 'm1.huge'",
+		},
+		{
+			Name: "quota exceeded",
+			Nodes: []*models.Node{
+				{Status: &models.NodeStatus{MachineName: "machine-1"}},
+				{Status: &models.NodeStatus{MachineName: "machine-2", ErrorReason: "CreateMachineError", ErrorMessage: "Quota exceeded for cores"}},
+			},
+			ExpectError: "machine 'machine-2': CreateMachineError: Quota exceeded for cores",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := terminalMachineError(tc.Nodes)
+			if tc.ExpectError == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.ExpectError {
+				t.Errorf("Want error '%s', got %v", tc.ExpectError, err)
+			}
+		})
+	}
+}
+
+func TestNodeDeploymentProgress(t *testing.T) {
+	now := time.Now()
+	nodes := []*models.Node{
+		{
+			Name: "node-1",
+			Status: &models.NodeStatus{
+				MachineName: "machine-1",
+				NodeInfo:    &models.NodeSystemInfo{KubeletVersion: "v1.21.8"},
+			},
+		},
+		{
+			Status: &models.NodeStatus{MachineName: "machine-3"},
+		},
+		{
+			Status: &models.NodeStatus{MachineName: "machine-2", ErrorReason: "CreateMachineError", ErrorMessage: "timeout"},
+		},
+	}
+	events := []*models.Event{
+		{Reason: "CreateMachineError", Message: "old", LastTimestamp: strfmt.DateTime(now.Add(-time.Hour)), InvolvedObject: &models.ObjectReferenceResource{Name: "machine-2"}},
+		{Reason: "CreateMachineError", Message: "timeout", LastTimestamp: strfmt.DateTime(now), InvolvedObject: &models.ObjectReferenceResource{Name: "machine-2"}},
+		{Reason: "CreateMachineError", Message: "timeout", LastTimestamp: strfmt.DateTime(now.Add(-time.Minute)), InvolvedObject: &models.ObjectReferenceResource{Name: "machine-2"}},
+	}
+
+	cases := []struct {
+		Name           string
+		NodeDeployment *models.NodeDeployment
+		Nodes          []*models.Node
+		Events         []*models.Event
+		Expected       string
+	}{
+		{
+			Name:           "replicas only",
+			NodeDeployment: testNodeDeployment(3, 1, 1, 0),
+			Expected:       "0/3 replicas ready, 1 updated",
+		},
+		{
+			Name:           "scale down",
+			NodeDeployment: testNodeDeployment(1, 3, 3, 3),
+			Expected:       "3/1 replicas ready, 3 updated; scaling down, 2 machines to be removed",
+		},
+		{
+			Name:           "machines and events",
+			NodeDeployment: testNodeDeployment(3, 3, 3, 1),
+			Nodes:          nodes,
+			Events:         events,
+			Expected: "1/3 replicas ready, 3 updated; " +
+				"machine 'machine-2': CreateMachineError: timeout; " +
+				"machine 'machine-3': node has not joined the cluster yet; " +
+				"event CreateMachineError 'machine-2': timeout; " +
+				"event CreateMachineError 'machine-2': old",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got := nodeDeploymentProgress(tc.NodeDeployment, tc.Nodes, tc.Events)
+			if diff := cmp.Diff(tc.Expected, got); diff != "" {
+				t.Fatalf("Unexpected progress, diff: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package kubermatic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// oidcConfig holds the settings to obtain ID tokens from an OIDC issuer.
+type oidcConfig struct {
+	issuerURL    string
+	clientID     string
+	clientSecret string
+	// refreshToken selects the refresh token grant, the client credentials grant is used otherwise
+	refreshToken string
+	scopes       []string
+}
+
+// oidcDiscovery is the subset of the OIDC discovery document used by the provider.
+type oidcDiscovery struct {
+	Issuer        string `json:"issuer"`
+	TokenEndpoint string `json:"token_endpoint"`
+}
+
+// newOIDCAuth returns an auth writer setting ID tokens obtained from the issuer.
+// Tokens are cached and refreshed before they expire, the token source is safe
+// to be used by concurrent requests.
+func newOIDCAuth(cfg oidcConfig, httpClient *http.Client) (runtime.ClientAuthInfoWriter, error) {
+	ts, err := newOIDCTokenSource(cfg, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	// fail early on invalid credentials instead of the first API call
+	if _, err := idToken(ts); err != nil {
+		return nil, err
+	}
+
+	return runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		token, err := idToken(ts)
+		if err != nil {
+			return err
+		}
+		return r.SetHeaderParam("Authorization", "Bearer "+token)
+	}), nil
+}
+
+func newOIDCTokenSource(cfg oidcConfig, httpClient *http.Client) (oauth2.TokenSource, error) {
+	if cfg.clientID == "" {
+		return nil, fmt.Errorf("oidc_client_id must be set to use OIDC authentication")
+	}
+
+	ctx := context.Background()
+	if httpClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+	}
+
+	discovery, err := discoverOIDCIssuer(ctx, cfg.issuerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := cfg.scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email"}
+	}
+
+	if cfg.refreshToken != "" {
+		c := &oauth2.Config{
+			ClientID:     cfg.clientID,
+			ClientSecret: cfg.clientSecret,
+			Endpoint:     oauth2.Endpoint{TokenURL: discovery.TokenEndpoint},
+			Scopes:       scopes,
+		}
+		return c.TokenSource(ctx, &oauth2.Token{RefreshToken: cfg.refreshToken}), nil
+	}
+
+	c := &clientcredentials.Config{
+		ClientID:     cfg.clientID,
+		ClientSecret: cfg.clientSecret,
+		TokenURL:     discovery.TokenEndpoint,
+		Scopes:       scopes,
+	}
+	return oauth2.ReuseTokenSource(nil, c.TokenSource(ctx)), nil
+}
+
+func discoverOIDCIssuer(ctx context.Context, issuerURL string) (*oidcDiscovery, error) {
+	wellKnown := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid oidc_issuer_url: %v", err)
+	}
+
+	client := http.DefaultClient
+	if c, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok {
+		client = c
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get OIDC discovery document: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to get OIDC discovery document: %s", resp.Status)
+	}
+
+	var discovery oidcDiscovery
+	if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+		return nil, fmt.Errorf("unable to decode OIDC discovery document: %v", err)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(issuerURL, "/") {
+		return nil, fmt.Errorf("OIDC issuer '%s' does not match oidc_issuer_url '%s'", discovery.Issuer, issuerURL)
+	}
+	if discovery.TokenEndpoint == "" {
+		return nil, fmt.Errorf("OIDC issuer '%s' does not provide a token endpoint", issuerURL)
+	}
+
+	return &discovery, nil
+}
+
+// idToken returns the ID token of the current token. Issuers not returning an
+// ID token, e.g. for the client credentials grant, are served the access token.
+func idToken(ts oauth2.TokenSource) (string, error) {
+	t, err := ts.Token()
+	if err != nil {
+		return "", fmt.Errorf("unable to obtain OIDC token: %v", err)
+	}
+	if id, ok := t.Extra("id_token").(string); ok && id != "" {
+		return id, nil
+	}
+	return t.AccessToken, nil
+}
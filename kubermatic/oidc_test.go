@@ -0,0 +1,222 @@
+package kubermatic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+)
+
+// mockOIDCIssuer serves a discovery document and a token endpoint issuing
+// numbered ID tokens.
+type mockOIDCIssuer struct {
+	*httptest.Server
+	// expiresIn is the lifetime of issued tokens in seconds
+	expiresIn int
+	issued    int32
+	// grants records the grant types of token requests
+	mu     sync.Mutex
+	grants []string
+}
+
+func newMockOIDCIssuer(t *testing.T, expiresIn int) *mockOIDCIssuer {
+	t.Helper()
+	m := &mockOIDCIssuer{expiresIn: expiresIn}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":         m.URL,
+			"token_endpoint": m.URL + "/token",
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		id, secret, ok := r.BasicAuth()
+		if !ok {
+			id, secret = r.FormValue("client_id"), r.FormValue("client_secret")
+		}
+		if id != "client" || secret != "secret" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+		grant := r.FormValue("grant_type")
+		if grant == "refresh_token" && r.FormValue("refresh_token") != "refresh" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		m.mu.Lock()
+		m.grants = append(m.grants, grant)
+		m.mu.Unlock()
+
+		n := atomic.AddInt32(&m.issued, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  fmt.Sprintf("access-%d", n),
+			"id_token":      fmt.Sprintf("id-%d", n),
+			"refresh_token": "refresh",
+			"token_type":    "Bearer",
+			"expires_in":    m.expiresIn,
+		})
+	})
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+	return m
+}
+
+func authHeader(t *testing.T, auth runtime.ClientAuthInfoWriter) string {
+	t.Helper()
+	r := &runtime.TestClientRequest{}
+	if err := auth.AuthenticateRequest(r, nil); err != nil {
+		t.Fatalf("Unexpected error authenticating request: %v", err)
+	}
+	return r.Headers.Get("Authorization")
+}
+
+func TestOIDCAuth(t *testing.T) {
+	cases := []struct {
+		Name         string
+		RefreshToken string
+		ExpectGrant  string
+	}{
+		{
+			Name:         "refresh token",
+			RefreshToken: "refresh",
+			ExpectGrant:  "refresh_token",
+		},
+		{
+			Name:        "client credentials",
+			ExpectGrant: "client_credentials",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			issuer := newMockOIDCIssuer(t, 3600)
+			auth, err := newOIDCAuth(oidcConfig{
+				issuerURL:    issuer.URL,
+				clientID:     "client",
+				clientSecret: "secret",
+				refreshToken: tc.RefreshToken,
+			}, issuer.Client())
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := authHeader(t, auth); got != "Bearer id-1" {
+				t.Errorf("Want header 'Bearer id-1', got '%s'", got)
+			}
+			if got := authHeader(t, auth); got != "Bearer id-1" {
+				t.Errorf("Want cached token 'Bearer id-1', got '%s'", got)
+			}
+			if len(issuer.grants) != 1 || issuer.grants[0] != tc.ExpectGrant {
+				t.Errorf("Want a single %s grant, got %v", tc.ExpectGrant, issuer.grants)
+			}
+		})
+	}
+}
+
+func TestOIDCAuthRefreshesExpiredToken(t *testing.T) {
+	// tokens expiring within the expiry delta of the token source are refreshed on every use
+	issuer := newMockOIDCIssuer(t, 1)
+	auth, err := newOIDCAuth(oidcConfig{
+		issuerURL:    issuer.URL,
+		clientID:     "client",
+		clientSecret: "secret",
+		refreshToken: "refresh",
+	}, issuer.Client())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got := authHeader(t, auth); got != "Bearer id-2" {
+		t.Errorf("Want refreshed header 'Bearer id-2', got '%s'", got)
+	}
+}
+
+func TestOIDCAuthConcurrent(t *testing.T) {
+	issuer := newMockOIDCIssuer(t, 3600)
+	auth, err := newOIDCAuth(oidcConfig{
+		issuerURL:    issuer.URL,
+		clientID:     "client",
+		clientSecret: "secret",
+		refreshToken: "refresh",
+	}, issuer.Client())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := &runtime.TestClientRequest{}
+			if err := auth.AuthenticateRequest(r, nil); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&issuer.issued); n != 1 {
+		t.Errorf("Want token source shared by all goroutines issuing 1 token, got %d", n)
+	}
+}
+
+func TestOIDCAuthErrors(t *testing.T) {
+	issuer := newMockOIDCIssuer(t, 3600)
+
+	cases := []struct {
+		Name        string
+		Config      oidcConfig
+		ExpectError string
+	}{
+		{
+			Name:        "missing client id",
+			Config:      oidcConfig{issuerURL: issuer.URL},
+			ExpectError: "oidc_client_id must be set",
+		},
+		{
+			Name:        "unknown issuer",
+			Config:      oidcConfig{issuerURL: issuer.URL + "/other", clientID: "client"},
+			ExpectError: "unable to get OIDC discovery document",
+		},
+		{
+			Name:        "issuer mismatch",
+			Config:      oidcConfig{issuerURL: strings.Replace(issuer.URL, "127.0.0.1", "localhost", 1), clientID: "client"},
+			ExpectError: "does not match oidc_issuer_url",
+		},
+		{
+			Name:        "invalid client",
+			Config:      oidcConfig{issuerURL: issuer.URL, clientID: "client", clientSecret: "wrong"},
+			ExpectError: "unable to obtain OIDC token",
+		},
+		{
+			Name:        "invalid refresh token",
+			Config:      oidcConfig{issuerURL: issuer.URL, clientID: "client", clientSecret: "secret", refreshToken: "wrong"},
+			ExpectError: "unable to obtain OIDC token",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := newOIDCAuth(tc.Config, issuer.Client())
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectError) {
+				t.Errorf("Want error containing '%s', got %v", tc.ExpectError, err)
+			}
+		})
+	}
+}
@@ -2,9 +2,10 @@ package kubermatic
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 	"os"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/go-openapi/runtime"
 	oclient "github.com/go-openapi/runtime/client"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	k8client "github.com/kubermatic/go-kubermatic/client"
 	"github.com/mitchellh/go-homedir"
@@ -30,6 +32,7 @@ type kubermaticProviderMeta struct {
 	client *k8client.KubermaticKubernetesPlatformAPI
 	auth   runtime.ClientAuthInfoWriter
 	log    *zap.SugaredLogger
+	cache  *metaCache
 }
 
 // Provider is a Kubermatic Terraform Provider.
@@ -58,6 +61,91 @@ func Provider() terraform.ResourceProvider {
 					"~/.kubermatic/auth"),
 				Description: "Path to the Kubermatic authentication token, defaults to ~/.kubermatic/auth",
 			},
+			"oidc_issuer_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBERMATIC_OIDC_ISSUER_URL", ""),
+				Description: "URL of the OIDC issuer to obtain ID tokens from, takes precedence over token and token_path",
+			},
+			"oidc_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBERMATIC_OIDC_CLIENT_ID", ""),
+				Description: "OIDC client ID",
+			},
+			"oidc_client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBERMATIC_OIDC_CLIENT_SECRET", ""),
+				Description: "OIDC client secret",
+			},
+			"oidc_refresh_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBERMATIC_OIDC_REFRESH_TOKEN", ""),
+				Description: "OIDC refresh token, the client credentials grant is used if not set",
+			},
+			"oidc_scopes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "OIDC scopes to request, defaults to openid and email",
+			},
+			"ca_certificate": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("KUBERMATIC_CA_CERTIFICATE", ""),
+				ConflictsWith: []string{"ca_file"},
+				Description:   "PEM encoded CA bundle to trust in addition to the system roots",
+			},
+			"ca_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("KUBERMATIC_CA_FILE", ""),
+				ConflictsWith: []string{"ca_certificate"},
+				Description:   "Path to a PEM encoded CA bundle to trust in addition to the system roots",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBERMATIC_INSECURE_SKIP_VERIFY", false),
+				Description: "Skip verification of the API server certificate, insecure and meant for testing only",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBERMATIC_PROXY_URL", ""),
+				Description: "URL of the HTTP(S) proxy to use, overrides HTTP_PROXY and HTTPS_PROXY environment variables",
+			},
+			"request_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBERMATIC_REQUEST_TIMEOUT", "0"),
+				ValidateFunc: validateDuration,
+				Description:  "Timeout of a single API request including its retries, e.g. 30s. 0 means no timeout",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBERMATIC_MAX_RETRIES", defaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of retries of API requests failing with 429, 5xx, connection errors or timeouts, non-idempotent requests only on 429, 503 or connection failures before sending. 0 disables retries",
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBERMATIC_RETRY_MAX_WAIT", defaultRetryMaxWait.String()),
+				ValidateFunc: validateDuration,
+				Description:  "Longest time to wait between two attempts of a failed API request, e.g. 30s",
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional HTTP headers sent with every API request",
+			},
 			"development": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -70,6 +158,13 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("KUBERMATIC_DEBUG", false),
 				Description: "Run debug mode.",
 			},
+			"cache_ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBERMATIC_CACHE_TTL", "5m"),
+				ValidateFunc: validateDuration,
+				Description:  "Time to cache datacenters, projects and versions for, e.g. 30s or 5m. 0 disables caching",
+			},
 			"log_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -87,11 +182,17 @@ func Provider() terraform.ResourceProvider {
 			"kubermatic_service_account_token": resourceServiceAccountToken(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"kubermatic_project":            dataSourceProject(),
-			"kubermatic_cluster":            dataSourceCluster(),
-			"kubermatic_cluster_kubeconfig": dataSourceClusterKubeconfigV2(),
-			"kubermatic_node_deployment":    dataSourceNodeDeployment(),
-			"kubermatic_sshkey":             dataSourceSSHKey(),
+			"kubermatic_project":               dataSourceProject(),
+			"kubermatic_cluster":               dataSourceCluster(),
+			"kubermatic_cluster_health":        dataSourceClusterHealth(),
+			"kubermatic_cluster_kubeconfig":    dataSourceClusterKubeconfigV2(),
+			"kubermatic_cluster_upgrades":      dataSourceClusterUpgrades(),
+			"kubermatic_datacenter":            dataSourceDatacenter(),
+			"kubermatic_datacenters":           dataSourceDatacenters(),
+			"kubermatic_node_deployment":       dataSourceNodeDeployment(),
+			"kubermatic_node_deployment_nodes": dataSourceNodeDeploymentNodes(),
+			"kubermatic_sshkey":                dataSourceSSHKey(),
+			"kubermatic_versions":              dataSourceVersions(),
 		},
 	}
 
@@ -119,10 +220,45 @@ func configure(d *schema.ResourceData, terraformVersion string, fd *os.File) (in
 	host := d.Get("host").(string)
 	token := d.Get("token").(string)
 	tokenPath := d.Get("token_path").(string)
-	return newKubermaticProviderMeta(logDev, logDebug, logPath, host, token, tokenPath, fd)
+	cacheTTL, err := time.ParseDuration(d.Get("cache_ttl").(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid cache_ttl: %v", err)
+	}
+
+	transport := transportConfig{
+		caCertificate:      d.Get("ca_certificate").(string),
+		caFile:             d.Get("ca_file").(string),
+		insecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		proxyURL:           d.Get("proxy_url").(string),
+		headers:            make(map[string]string),
+		maxRetries:         d.Get("max_retries").(int),
+	}
+	transport.timeout, err = time.ParseDuration(d.Get("request_timeout").(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid request_timeout: %v", err)
+	}
+	transport.retryMaxWait, err = time.ParseDuration(d.Get("retry_max_wait").(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid retry_max_wait: %v", err)
+	}
+	for k, v := range d.Get("headers").(map[string]interface{}) {
+		transport.headers[k] = v.(string)
+	}
+
+	oidc := oidcConfig{
+		issuerURL:    d.Get("oidc_issuer_url").(string),
+		clientID:     d.Get("oidc_client_id").(string),
+		clientSecret: d.Get("oidc_client_secret").(string),
+		refreshToken: d.Get("oidc_refresh_token").(string),
+	}
+	for _, s := range d.Get("oidc_scopes").([]interface{}) {
+		oidc.scopes = append(oidc.scopes, s.(string))
+	}
+
+	return newKubermaticProviderMeta(logDev, logDebug, logPath, host, token, tokenPath, oidc, cacheTTL, transport, fd)
 }
 
-func newKubermaticProviderMeta(logDev, logDebug bool, logPath, host, token, tokenPath string, fd *os.File) (*kubermaticProviderMeta, error) {
+func newKubermaticProviderMeta(logDev, logDebug bool, logPath, host, token, tokenPath string, oidc oidcConfig, cacheTTL time.Duration, transport transportConfig, fd *os.File) (*kubermaticProviderMeta, error) {
 	var (
 		k   kubermaticProviderMeta
 		err error
@@ -133,16 +269,33 @@ func newKubermaticProviderMeta(logDev, logDebug bool, logPath, host, token, toke
 		return nil, err
 	}
 
-	k.client, err = newClient(host)
+	transport.log = k.log
+	httpClient, err := newHTTPClient(transport)
+	if err != nil {
+		return nil, err
+	}
+
+	k.client, err = newClient(host, httpClient)
 	if err != nil {
 		return nil, err
 	}
 
-	k.auth, err = newAuth(token, tokenPath)
+	if oidc.issuerURL != "" {
+		var oidcClient *http.Client
+		oidcClient, err = newOIDCHTTPClient(transport)
+		if err != nil {
+			return nil, err
+		}
+		k.auth, err = newOIDCAuth(oidc, oidcClient)
+	} else {
+		k.auth, err = newAuth(token, tokenPath)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	k.cache = newMetaCache(cacheTTL)
+
 	return &k, nil
 }
 
@@ -184,17 +337,32 @@ func newLogger(logDev, logDebug bool, logPath string, fd *os.File) (*zap.Sugared
 	return zap.New(core).Sugar(), nil
 }
 
-func newClient(host string) (*k8client.KubermaticKubernetesPlatformAPI, error) {
+func newClient(host string, httpClient *http.Client) (*k8client.KubermaticKubernetesPlatformAPI, error) {
 	u, err := url.Parse(host)
 	if err != nil {
 		return nil, err
 	}
 
-	return k8client.NewHTTPClientWithConfig(nil, &k8client.TransportConfig{
-		Host:     u.Host,
-		BasePath: u.Path,
-		Schemes:  []string{u.Scheme},
-	}), nil
+	transport := oclient.NewWithClient(u.Host, u.Path, []string{u.Scheme}, httpClient)
+	// kubeconfig endpoints respond with YAML, which is not handled by the generated client
+	transport.Consumers["application/yaml"] = runtime.ByteStreamConsumer()
+
+	return k8client.New(&apiErrorTransport{&operationContextTransport{transport}}, nil), nil
+}
+
+// operationContextTransport sets a context on operations without one. The
+// runtime limits those to the 30s default timeout of the generated params,
+// which would cut off retries and Retry-After waits. Requests are limited by
+// request_timeout of the HTTP client instead.
+type operationContextTransport struct {
+	runtime.ClientTransport
+}
+
+func (t *operationContextTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	if op.Context == nil {
+		op.Context = context.Background()
+	}
+	return t.ClientTransport.Submit(op)
 }
 
 func newAuth(token, tokenPath string) (runtime.ClientAuthInfoWriter, error) {
@@ -215,11 +383,9 @@ func newAuth(token, tokenPath string) (runtime.ClientAuthInfoWriter, error) {
 	return oclient.BearerToken(token), nil
 }
 
-// getErrorResponse converts the client error response to string
-func getErrorResponse(err error) string {
-	rawData, newErr := json.Marshal(err)
-	if newErr != nil {
-		return err.Error()
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration, e.g. 30s or 5m: %v", k, err))
 	}
-	return string(rawData)
+	return
 }
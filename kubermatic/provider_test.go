@@ -42,6 +42,15 @@ const (
 	testEnvAWSSubnetID         = "KUBERMATIC_AWS_SUBNET_ID"
 	testEnvAWSAvailabilityZone = "KUBERMATIC_AWS_AVAILABILITY_ZONE"
 	testEnvAWSDiskSize         = "KUBERMATIC_AWS_DISK_SIZE"
+
+	testEnvGCPServiceAccount = "KUBERMATIC_GCP_SERVICE_ACCOUNT"
+	testEnvGCPNodeDC         = "KUBERMATIC_GCP_NODE_DC"
+	testEnvGCPMachineType    = "KUBERMATIC_GCP_MACHINE_TYPE"
+	testEnvGCPZone           = "KUBERMATIC_GCP_ZONE"
+
+	testEnvVSphereUsername = "KUBERMATIC_VSPHERE_USERNAME"
+	testEnvVSpherePassword = "KUBERMATIC_VSPHERE_PASSWORD"
+	testEnvVSphereNodeDC   = "KUBERMATIC_VSPHERE_NODE_DC"
 )
 
 var (
@@ -89,6 +98,23 @@ func testAccPreCheckForAWS(t *testing.T) {
 	checkEnv(t, testEnvAWSNodeDC)
 }
 
+func testAccPreCheckForGCP(t *testing.T) {
+	t.Helper()
+	testAccPreCheck(t)
+	checkEnv(t, testEnvGCPServiceAccount)
+	checkEnv(t, testEnvGCPNodeDC)
+	checkEnv(t, testEnvGCPMachineType)
+	checkEnv(t, testEnvGCPZone)
+}
+
+func testAccPreCheckForVSphere(t *testing.T) {
+	t.Helper()
+	testAccPreCheck(t)
+	checkEnv(t, testEnvVSphereUsername)
+	checkEnv(t, testEnvVSpherePassword)
+	checkEnv(t, testEnvVSphereNodeDC)
+}
+
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 	checkEnv(t, "KUBERMATIC_HOST")
@@ -1,8 +1,10 @@
 package kubermatic
 
 import (
+	"errors"
 	"fmt"
-	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
@@ -11,16 +13,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/kubermatic/go-kubermatic/client/datacenter"
 	"github.com/kubermatic/go-kubermatic/client/project"
-	"github.com/kubermatic/go-kubermatic/client/versions"
 	"github.com/kubermatic/go-kubermatic/models"
 )
 
 const (
-	healthStatusUp models.HealthStatus = 1
+	healthStatusDown         models.HealthStatus = 0
+	healthStatusUp           models.HealthStatus = 1
+	healthStatusProvisioning models.HealthStatus = 2
+
+	// statusReady and statusNotReady are reported in the status attribute of
+	// clusters and node deployments.
+	statusReady    = "ready"
+	statusNotReady = "not_ready"
 )
 
-var supportedProviders = []string{"aws", "openstack", "azure"}
-
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClusterCreate,
@@ -30,6 +36,11 @@ func resourceCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -94,8 +105,41 @@ func resourceCluster() *schema.Resource {
 				Computed:    true,
 				Description: "Deletion timestamp",
 			},
-			// TODO: uncomment once `no consumer: "application/yaml"` error in kubermatic client is fixed.
-			// "kube_config": kubernetesConfigSchema(),
+			"kube_config": kubernetesConfigSchema(),
+			"health": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Health status of the control plane components",
+				Elem: &schema.Resource{
+					Schema: clusterHealthFields(),
+				},
+			},
+			"ready_components": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Components that have to be up for the cluster to be ready, defaults to all but gatekeeper and MLA components",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(clusterHealthComponentNames(), false),
+				},
+			},
+			"wait_for_ready": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Wait for the ready components to be up on create and update, otherwise return once the API accepted the cluster",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Readiness of the cluster, either ready or not_ready",
+			},
+			"upgrade_node_deployments": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Upgrade kubelets of all node deployments to the cluster version once the control plane is upgraded",
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -127,16 +171,22 @@ func validateVersionExists() schema.CustomizeDiffFunc {
 	return func(d *schema.ResourceDiff, meta interface{}) error {
 		k := meta.(*kubermaticProviderMeta)
 		version := d.Get("spec.0.version").(string)
-		p := versions.NewGetMasterVersionsParams()
-		r, err := k.client.Versions.GetMasterVersions(p, k.auth)
-		if err != nil {
-			if e, ok := err.(*versions.GetMasterVersionsDefault); ok && errorMessage(e.Payload) != "" {
-				return fmt.Errorf("get cluster upgrades: %s", errorMessage(e.Payload))
+
+		// existing clusters can only be upgraded to versions the API offers for them,
+		// downgrades force a new cluster and are validated as such
+		if d.Id() != "" && d.HasChange("spec.0.version") {
+			old, _ := d.GetChange("spec.0.version")
+			if isVersionUpgrade(old.(string), version) {
+				return validateVersionIsClusterUpgrade(d, k, version)
 			}
+		}
+
+		masterVersions, err := getMasterVersions(k, "")
+		if err != nil {
 			return err
 		}
 
-		for _, v := range r.Payload {
+		for _, v := range masterVersions {
 			if s, ok := v.Version.(string); ok && s == version {
 				return nil
 			}
@@ -146,11 +196,79 @@ func validateVersionExists() schema.CustomizeDiffFunc {
 	}
 }
 
+func validateVersionIsClusterUpgrade(d *schema.ResourceDiff, k *kubermaticProviderMeta, version string) error {
+	dc, err := getDatacenterByName(k, d.Get("dc_name").(string))
+	if err != nil {
+		return err
+	}
+
+	upgrades, err := getClusterUpgrades(k, d.Get("project_id").(string), dc.Spec.Seed, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := validateClusterUpgrade(upgrades, version); err != nil {
+		return fmt.Errorf("cluster '%s' cannot be upgraded to version %s: %v", d.Id(), version, err)
+	}
+	return nil
+}
+
+// validateClusterUpgrade checks that version is one of the upgrades. Upgrades
+// restricted by the kubelet versions of the nodes are rejected by the API, so
+// they are not accepted either.
+func validateClusterUpgrade(upgrades []*models.MasterVersion, version string) error {
+	var available, restricted []string
+	for _, v := range upgrades {
+		if v == nil {
+			continue
+		}
+		s, ok := v.Version.(string)
+		if !ok {
+			continue
+		}
+		if v.RestrictedByKubeletVersion {
+			restricted = append(restricted, s)
+			continue
+		}
+		if s == version {
+			return nil
+		}
+		available = append(available, s)
+	}
+	return fmt.Errorf("available upgrades: %v, restricted by kubelet versions of the nodes: %v", available, restricted)
+}
+
+func getClusterUpgrades(k *kubermaticProviderMeta, projectID, seedDC, clusterID string) ([]*models.MasterVersion, error) {
+	p := project.NewGetClusterUpgradesParams()
+	p.SetProjectID(projectID)
+	p.SetDC(seedDC)
+	p.SetClusterID(clusterID)
+
+	r, err := k.client.Project.GetClusterUpgrades(p, k.auth)
+	if err != nil {
+		return nil, fmt.Errorf("get cluster upgrades: %v", err)
+	}
+	return r.Payload, nil
+}
+
+// isVersionUpgrade returns true if new version is greater than old one.
+func isVersionUpgrade(old, new string) bool {
+	oldVer, err := version.NewVersion(old)
+	if err != nil {
+		return false
+	}
+	newVer, err := version.NewVersion(new)
+	if err != nil {
+		return false
+	}
+	return newVer.GreaterThan(oldVer)
+}
+
 func validateOnlyOneCloudProviderSpecified() schema.CustomizeDiffFunc {
 	return func(d *schema.ResourceDiff, meta interface{}) error {
 		var existingProviders []string
 		counter := 0
-		for _, provider := range supportedProviders {
+		for _, provider := range cloudProviderNames() {
 			if _, ok := d.GetOk(fmt.Sprintf("spec.0.cloud.0.%s.0", provider)); ok {
 				existingProviders = append(existingProviders, provider)
 				counter++
@@ -188,7 +306,7 @@ func resourceClusterCreate(d *schema.ResourceData, m interface{}) error {
 
 	r, err := k.client.Project.CreateCluster(p, k.auth)
 	if err != nil {
-		return fmt.Errorf("unable to create cluster for project '%s': %s", pID, getErrorResponse(err))
+		return fmt.Errorf("unable to create cluster for project '%s': %v", pID, err)
 	}
 	d.SetId(r.Payload.ID)
 
@@ -203,11 +321,10 @@ func resourceClusterCreate(d *schema.ResourceData, m interface{}) error {
 
 	projectID := d.Get("project_id").(string)
 	seedDC := dc.Spec.Seed
-	if err != nil {
-		return err
-	}
-	if err := waitClusterReady(k, d, projectID, seedDC, r.Payload.ID); err != nil {
-		return fmt.Errorf("cluster '%s' is not ready: %v", r.Payload.ID, err)
+	if d.Get("wait_for_ready").(bool) {
+		if err := waitClusterReady(k, d.Timeout(schema.TimeoutCreate), projectID, seedDC, r.Payload.ID, clusterReadyComponents(d)); err != nil {
+			return fmt.Errorf("cluster '%s' is not ready: %v", r.Payload.ID, err)
+		}
 	}
 
 	return resourceClusterRead(d, m)
@@ -226,16 +343,12 @@ func getLabels(d *schema.ResourceData) map[string]string {
 }
 
 func getDatacenterByName(k *kubermaticProviderMeta, name string) (*models.Datacenter, error) {
-	p := datacenter.NewListDatacentersParams()
-	r, err := k.client.Datacenter.ListDatacenters(p, k.auth)
+	dcs, err := listDatacenters(k)
 	if err != nil {
-		if e, ok := err.(*datacenter.ListDatacentersDefault); ok && errorMessage(e.Payload) != "" {
-			return nil, fmt.Errorf("list datacenters: %s", errorMessage(e.Payload))
-		}
-		return nil, fmt.Errorf("list datacenters: %v", err)
+		return nil, err
 	}
 
-	for _, v := range r.Payload {
+	for _, v := range dcs {
 		if v.Metadata.Name == name {
 			return v, nil
 		}
@@ -244,6 +357,21 @@ func getDatacenterByName(k *kubermaticProviderMeta, name string) (*models.Datace
 	return nil, fmt.Errorf("Datacenter '%s' not found", name)
 }
 
+func listDatacenters(k *kubermaticProviderMeta) ([]*models.Datacenter, error) {
+	v, err := k.cache.get(datacentersCacheKey(), func() (interface{}, error) {
+		p := datacenter.NewListDatacentersParams()
+		r, err := k.client.Datacenter.ListDatacenters(p, k.auth)
+		if err != nil {
+			return nil, fmt.Errorf("list datacenters: %v", err)
+		}
+		return r.Payload, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*models.Datacenter), nil
+}
+
 func resourceClusterRead(d *schema.ResourceData, m interface{}) error {
 	k := m.(*kubermaticProviderMeta)
 	p := project.NewGetClusterParams()
@@ -260,14 +388,9 @@ func resourceClusterRead(d *schema.ResourceData, m interface{}) error {
 	p.SetClusterID(clusterID)
 
 	r, err := k.client.Project.GetCluster(p, k.auth)
-	if getClusterErrResourceIsDeleted(err) {
-		k.log.Infof("removing cluster '%s' from terraform state file, could not find the resource", d.Id())
-		d.SetId("")
-		return nil
-	}
 	if err != nil {
-		if e, ok := err.(*project.GetClusterDefault); ok && e.Code() == http.StatusNotFound {
-			k.log.Infof("removing cluster '%s' from terraform state file, could not find the resource", d.Id())
+		if isClusterGone(err) {
+			k.log.Infof("removing cluster '%s' from terraform state file: %v", d.Id(), err)
 			d.SetId("")
 			return nil
 		}
@@ -277,7 +400,7 @@ func resourceClusterRead(d *schema.ResourceData, m interface{}) error {
 		// the GET request returns 500 http code instead of 404, probably it's a bug
 		// because of that manual action to clean terraform state file is required
 
-		return fmt.Errorf("unable to get cluster '%s': %s", d.Id(), getErrorResponse(err))
+		return fmt.Errorf("unable to get cluster '%s': %v", d.Id(), err)
 	}
 
 	d.Set("project_id", projectID)
@@ -311,6 +434,30 @@ func resourceClusterRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("deletion_timestamp", r.Payload.DeletionTimestamp.String())
 
+	// the control plane of a cluster created without waiting for it may not be
+	// up yet, so the kubeconfig and health are only available once it is ready
+	status := statusNotReady
+	health, err := getClusterHealth(k, projectID, dc.Spec.Seed, clusterID)
+	if err != nil {
+		if !clusterNotAvailableYet(err, nil) {
+			return err
+		}
+		k.log.Debugf("cluster '%s' is not ready yet: %v", clusterID, err)
+	}
+	if err := kubermaticClusterSetKubeConfig(d, k, projectID); err != nil {
+		if !clusterNotAvailableYet(err, health) {
+			return err
+		}
+		k.log.Debugf("cluster '%s' is not ready yet: %v", clusterID, err)
+		d.Set("kube_config", []interface{}{})
+	} else if health != nil {
+		status = clusterStatus(health, clusterReadyComponents(d))
+	}
+	if err := d.Set("health", flattenClusterHealth(health)); err != nil {
+		return err
+	}
+	d.Set("status", status)
+
 	keys, err := kubermaticClusterGetAssignedSSHKeys(d, k)
 	if err != nil {
 		return err
@@ -322,34 +469,47 @@ func resourceClusterRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func getClusterErrResourceIsDeleted(err error) bool {
-	if err == nil {
+// clusterNotAvailableYet reports whether the error of getting the kubeconfig or
+// health of a cluster means its control plane is not up yet. The API responds
+// with not found or conflict until the apiserver is up, other errors are real.
+func clusterNotAvailableYet(err error, health *models.ClusterHealth) bool {
+	if !errors.Is(err, errNotFound) && !errors.Is(err, errConflict) {
 		return false
 	}
+	return health == nil || health.Apiserver != healthStatusUp
+}
 
-	e, ok := err.(*project.GetClusterDefault)
-	if !ok {
-		return false
+// isClusterGone reports whether the error of getting a cluster means it has
+// been deleted.
+func isClusterGone(err error) bool {
+	if isResourceGone(err) {
+		return true
 	}
-
-	// All api replies and errors, that nevertheless indicate cluster was deleted.
 	// TODO: adjust when https://github.com/kubermatic/kubermatic/issues/5462 fixed
-	return e.Code() == http.StatusNotFound || errorMessage(e.Payload) == "no userInfo in request"
+	// the API responds with this message to requests for deleted clusters
+	var e *project.GetClusterDefault
+	return errors.As(err, &e) && errorResponseMessage(e.Payload) == "no userInfo in request"
 }
 
 // excludeProjectLabels excludes labels defined in project.
 // Project labels propogated to clusters. For better predictability of
 // cluster's labels changes, project's labels are excluded from cluster state.
 func excludeProjectLabels(k *kubermaticProviderMeta, projectID string, allLabels map[string]string) (map[string]string, error) {
-	p := project.NewGetProjectParams()
-	p.SetProjectID(projectID)
+	v, err := k.cache.get(projectCacheKey(projectID), func() (interface{}, error) {
+		p := project.NewGetProjectParams()
+		p.SetProjectID(projectID)
 
-	r, err := k.client.Project.GetProject(p, k.auth)
+		r, err := k.client.Project.GetProject(p, k.auth)
+		if err != nil {
+			return nil, err
+		}
+		return r.Payload, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	for k := range r.Payload.Labels {
+	for k := range v.(*models.Project).Labels {
 		delete(allLabels, k)
 	}
 
@@ -380,6 +540,22 @@ func kubermaticClusterGetAssignedSSHKeys(d *schema.ResourceData, k *kubermaticPr
 	return ids, nil
 }
 
+func kubermaticClusterSetKubeConfig(d *schema.ResourceData, k *kubermaticProviderMeta, projectID string) error {
+	p := project.NewGetClusterKubeconfigV2Params()
+	p.SetProjectID(projectID)
+	p.SetClusterID(d.Id())
+	r, err := k.client.Project.GetClusterKubeconfigV2(p, k.auth)
+	if err != nil {
+		return fmt.Errorf("unable to get cluster kubeconfig '%s': %w", d.Id(), err)
+	}
+
+	kubeConfig, err := flattenKubeConfig(r.Payload)
+	if err != nil {
+		return fmt.Errorf("unable to parse cluster kubeconfig '%s': %v", d.Id(), err)
+	}
+	return d.Set("kube_config", kubeConfig)
+}
+
 // clusterPreserveValues helps avoid misleading diffs during read phase.
 // API result does not have some important fields valeus, like sensitive
 // access key or password fields. When API result is flattened and written to
@@ -391,6 +567,16 @@ type clusterPreserveValues struct {
 	// API returns empty spec for Azure and AWS clusters, so we just preserve values used for creation
 	azure *models.AzureCloudSpec
 	aws   *models.AWSCloudSpec
+	// API does not return the service account for GCP clusters
+	gcp *models.GCPCloudSpec
+	// API does not return credentials for vSphere clusters
+	vsphere *models.VSphereCloudSpec
+	// API does not return tokens and keys for Hetzner, DigitalOcean and Packet clusters
+	hetzner      *models.HetznerCloudSpec
+	digitalocean *models.DigitaloceanCloudSpec
+	packet       *models.PacketCloudSpec
+	// API does not return the kubeconfig for KubeVirt clusters
+	kubevirt *models.KubevirtCloudSpec
 }
 
 type clusterOpenstackPreservedValues struct {
@@ -441,10 +627,61 @@ func readClusterPreserveValues(d *schema.ResourceData) clusterPreserveValues {
 		}
 	}
 
+	var gcp *models.GCPCloudSpec
+	if _, ok := d.GetOk(key("gcp.0")); ok {
+		gcp = &models.GCPCloudSpec{
+			ServiceAccount: d.Get(key("gcp.0.service_account")).(string),
+		}
+	}
+
+	var vsphere *models.VSphereCloudSpec
+	if _, ok := d.GetOk(key("vsphere.0")); ok {
+		vsphere = &models.VSphereCloudSpec{
+			Username:            d.Get(key("vsphere.0.username")).(string),
+			Password:            d.Get(key("vsphere.0.password")).(string),
+			InfraManagementUser: expandVSphereCredentials(d.Get(key("vsphere.0.infra_management_user")).([]interface{})),
+		}
+	}
+
+	var hetzner *models.HetznerCloudSpec
+	if _, ok := d.GetOk(key("hetzner.0")); ok {
+		hetzner = &models.HetznerCloudSpec{
+			Token: d.Get(key("hetzner.0.token")).(string),
+		}
+	}
+
+	var digitalocean *models.DigitaloceanCloudSpec
+	if _, ok := d.GetOk(key("digitalocean.0")); ok {
+		digitalocean = &models.DigitaloceanCloudSpec{
+			Token: d.Get(key("digitalocean.0.token")).(string),
+		}
+	}
+
+	var packet *models.PacketCloudSpec
+	if _, ok := d.GetOk(key("packet.0")); ok {
+		packet = &models.PacketCloudSpec{
+			APIKey:    d.Get(key("packet.0.api_key")).(string),
+			ProjectID: d.Get(key("packet.0.project_id")).(string),
+		}
+	}
+
+	var kubevirt *models.KubevirtCloudSpec
+	if _, ok := d.GetOk(key("kubevirt.0")); ok {
+		kubevirt = &models.KubevirtCloudSpec{
+			Kubeconfig: d.Get(key("kubevirt.0.kubeconfig")).(string),
+		}
+	}
+
 	return clusterPreserveValues{
 		openstack,
 		azure,
 		aws,
+		gcp,
+		vsphere,
+		hetzner,
+		digitalocean,
+		packet,
+		kubevirt,
 	}
 }
 
@@ -454,8 +691,13 @@ func resourceClusterUpdate(d *schema.ResourceData, m interface{}) error {
 
 	k := m.(*kubermaticProviderMeta)
 
+	// patching and waiting for the cluster and its node deployments share the update timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+
+	versionUpgraded := d.HasChange("spec.0.version")
+
 	if d.HasChanges("name", "labels", "spec") {
-		if err := patchClusterFields(d, k); err != nil {
+		if err := patchClusterFields(d, k, time.Until(deadline)); err != nil {
 			return err
 		}
 		d.SetPartial("name")
@@ -476,15 +718,87 @@ func resourceClusterUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 	clusterID := d.Id()
+	upgradeNodeDeployments := versionUpgraded && d.Get("upgrade_node_deployments").(bool)
+
+	// node deployments can only be upgraded once the control plane is ready again,
+	// so the cluster is waited for even without wait_for_ready
+	if d.Get("wait_for_ready").(bool) || upgradeNodeDeployments {
+		if err := waitClusterReady(k, time.Until(deadline), projectID, dc.Spec.Seed, clusterID, clusterReadyComponents(d)); err != nil {
+			return fmt.Errorf("cluster '%s' is not ready: %v", d.Id(), err)
+		}
+	}
 
-	if err := waitClusterReady(k, d, projectID, dc.Spec.Seed, clusterID); err != nil {
-		return fmt.Errorf("cluster '%s' is not ready: %v", d.Id(), err)
+	if upgradeNodeDeployments {
+		if err := upgradeClusterNodeDeployments(k, d, time.Until(deadline), projectID, dc.Spec.Seed, clusterID); err != nil {
+			return err
+		}
 	}
 
 	return resourceClusterRead(d, m)
 }
 
-func patchClusterFields(d *schema.ResourceData, k *kubermaticProviderMeta) error {
+func upgradeClusterNodeDeployments(k *kubermaticProviderMeta, d *schema.ResourceData, timeout time.Duration, projectID, seedDC, clusterID string) error {
+	version := d.Get("spec.0.version").(string)
+
+	p := project.NewUpgradeClusterNodeDeploymentsParams()
+	p.SetProjectID(projectID)
+	p.SetDC(seedDC)
+	p.SetClusterID(clusterID)
+	p.SetBody(&models.MasterVersion{
+		Version: version,
+	})
+
+	_, err := k.client.Project.UpgradeClusterNodeDeployments(p, k.auth)
+	if err != nil {
+		return fmt.Errorf("unable to upgrade node deployments of cluster '%s': %v", clusterID, err)
+	}
+
+	return waitClusterNodeDeploymentsUpgraded(k, timeout, projectID, seedDC, clusterID, version)
+}
+
+func waitClusterNodeDeploymentsUpgraded(k *kubermaticProviderMeta, timeout time.Duration, projectID, seedDC, clusterID, version string) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		p := project.NewListNodeDeploymentsParams()
+		p.SetProjectID(projectID)
+		p.SetDC(seedDC)
+		p.SetClusterID(clusterID)
+
+		r, err := k.client.Project.ListNodeDeployments(p, k.auth)
+		if err != nil {
+			listErr := fmt.Errorf("unable to list node deployments of cluster '%s': %v", clusterID, err)
+			if isTransientError(err) {
+				return resource.RetryableError(listErr)
+			}
+			return resource.NonRetryableError(listErr)
+		}
+
+		for _, nd := range r.Payload {
+			if !nodeDeploymentUpgraded(nd, version) {
+				k.log.Debugf("waiting for node deployment '%s' to be upgraded to %s, %+v", nd.ID, version, nd.Status)
+				return resource.RetryableError(fmt.Errorf("waiting for node deployment '%s' to be upgraded to %s", nd.ID, version))
+			}
+		}
+		return nil
+	})
+}
+
+// nodeDeploymentUpgraded returns true if the node deployment runs the kubelet
+// version and all its replicas are updated and ready.
+func nodeDeploymentUpgraded(nd *models.NodeDeployment, version string) bool {
+	if nd.Spec == nil || nd.Spec.Template == nil || nd.Spec.Template.Versions == nil || nd.Spec.Template.Versions.Kubelet != version {
+		return false
+	}
+	var replicas int32
+	if nd.Spec.Replicas != nil {
+		replicas = *nd.Spec.Replicas
+	}
+	if replicas == 0 {
+		return true
+	}
+	return nd.Status != nil && nd.Status.UpdatedReplicas >= replicas && nd.Status.ReadyReplicas >= replicas
+}
+
+func patchClusterFields(d *schema.ResourceData, k *kubermaticProviderMeta, timeout time.Duration) error {
 	p := project.NewPatchClusterParams()
 	projectID := d.Get("project_id").(string)
 	dc_name := d.Get("dc_name").(string)
@@ -503,13 +817,11 @@ func patchClusterFields(d *schema.ResourceData, k *kubermaticProviderMeta) error
 	labels := d.Get("labels")
 	p.SetPatch(newClusterPatch(name, version, auditLogging, labels))
 
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.Retry(timeout, func() *resource.RetryError {
 		_, err := k.client.Project.PatchCluster(p, k.auth)
 		if err != nil {
-			if e, ok := err.(*project.PatchClusterDefault); ok && e.Code() == http.StatusConflict {
+			if errors.Is(err, errConflict) {
 				return resource.RetryableError(fmt.Errorf("cluster patch conflict: %v", err))
-			} else if ok && errorMessage(e.Payload) != "" {
-				return resource.NonRetryableError(fmt.Errorf("patch cluster '%s': %s", d.Id(), errorMessage(e.Payload)))
 			}
 			return resource.NonRetryableError(fmt.Errorf("patch cluster '%s': %v", d.Id(), err))
 		}
@@ -554,10 +866,10 @@ func updateClusterSSHKeys(d *schema.ResourceData, k *kubermaticProviderMeta) err
 		p.SetKeyID(id)
 		_, err := k.client.Project.DetachSSHKeyFromCluster(p, k.auth)
 		if err != nil {
-			if e, ok := err.(*project.DetachSSHKeyFromClusterDefault); ok && e.Code() == http.StatusNotFound {
+			if errors.Is(err, errNotFound) {
 				continue
 			}
-			return err
+			return fmt.Errorf("unable to detach sshkey '%s' from cluster '%s': %v", id, clusterID, err)
 		}
 	}
 
@@ -584,31 +896,50 @@ func assignSSHKeysToCluster(projectID, seedDC, clusterID string, sshkeyIDs []str
 	return nil
 }
 
-func waitClusterReady(k *kubermaticProviderMeta, d *schema.ResourceData, projectID, seedDC, clusterID string) error {
-	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+// clusterReadyComponents returns the components configured to be up for the cluster to be ready.
+func clusterReadyComponents(d *schema.ResourceData) []string {
+	return readyComponents(d.Get("ready_components").(*schema.Set))
+}
 
-		p := project.NewGetClusterHealthParams()
-		p.SetProjectID(projectID)
-		p.SetDC(seedDC)
-		p.SetClusterID(clusterID)
+// readyComponents returns the components of the set, or the default ones if it is empty.
+func readyComponents(set *schema.Set) []string {
+	var components []string
+	for _, v := range set.List() {
+		components = append(components, v.(string))
+	}
+	if len(components) == 0 {
+		return defaultClusterReadyComponents
+	}
+	return components
+}
+
+func getClusterHealth(k *kubermaticProviderMeta, projectID, seedDC, clusterID string) (*models.ClusterHealth, error) {
+	p := project.NewGetClusterHealthParams()
+	p.SetProjectID(projectID)
+	p.SetDC(seedDC)
+	p.SetClusterID(clusterID)
+
+	r, err := k.client.Project.GetClusterHealth(p, k.auth)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get cluster '%s' health: %w", clusterID, err)
+	}
+	return r.Payload, nil
+}
 
-		r, err := k.client.Project.GetClusterHealth(p, k.auth)
+func waitClusterReady(k *kubermaticProviderMeta, timeout time.Duration, projectID, seedDC, clusterID string, components []string) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		health, err := getClusterHealth(k, projectID, seedDC, clusterID)
 		if err != nil {
-			return resource.RetryableError(fmt.Errorf("unable to get cluster '%s' health: %s", d.Id(), getErrorResponse(err)))
+			return resource.RetryableError(err)
 		}
 
-		if r.Payload.Apiserver == healthStatusUp &&
-			r.Payload.CloudProviderInfrastructure == healthStatusUp &&
-			r.Payload.Controller == healthStatusUp &&
-			r.Payload.Etcd == healthStatusUp &&
-			r.Payload.MachineController == healthStatusUp &&
-			r.Payload.Scheduler == healthStatusUp &&
-			r.Payload.UserClusterControllerManager == healthStatusUp {
+		notReady := clusterComponentsNotReady(health, components)
+		if len(notReady) == 0 {
 			return nil
 		}
 
-		k.log.Debugf("waiting for cluster '%s' to be ready, %+v", d.Id(), r.Payload)
-		return resource.RetryableError(fmt.Errorf("waiting for cluster '%s' to be ready", d.Id()))
+		k.log.Debugf("waiting for cluster '%s' to be ready, %+v", clusterID, health)
+		return resource.RetryableError(fmt.Errorf("waiting for cluster '%s' to be ready, components not up: %s", clusterID, strings.Join(notReady, ", ")))
 	})
 }
 
@@ -647,18 +978,14 @@ func resourceClusterDelete(d *schema.ResourceData, m interface{}) error {
 		if !deleteSent {
 			_, err := k.client.Project.DeleteCluster(p, k.auth)
 			if err != nil {
-				if e, ok := err.(*project.DeleteClusterDefault); ok {
-					if e.Code() == http.StatusConflict {
-						return resource.RetryableError(err)
-					}
-					if e.Code() == http.StatusNotFound {
-						return nil
-					}
+				if errors.Is(err, errConflict) {
+					return resource.RetryableError(err)
 				}
-				if _, ok := err.(*project.DeleteClusterForbidden); ok {
+				if errors.Is(err, errNotFound) {
+					k.log.Debugf("cluster '%s' has already been deleted: %v", d.Id(), err)
 					return nil
 				}
-				return resource.NonRetryableError(fmt.Errorf("unable to delete cluster '%s': %s", d.Id(), getErrorResponse(err)))
+				return resource.NonRetryableError(fmt.Errorf("unable to delete cluster '%s': %v", d.Id(), err))
 			}
 			deleteSent = true
 		}
@@ -670,14 +997,11 @@ func resourceClusterDelete(d *schema.ResourceData, m interface{}) error {
 
 		r, err := k.client.Project.GetCluster(p, k.auth)
 		if err != nil {
-			if e, ok := err.(*project.GetClusterDefault); ok && e.Code() == http.StatusNotFound {
-				k.log.Debugf("cluster '%s' has been destroyed, returned http code: %d", d.Id(), e.Code())
-				return nil
-			}
-			if _, ok := err.(*project.GetClusterForbidden); ok {
+			if isClusterGone(err) {
+				k.log.Debugf("cluster '%s' has been destroyed: %v", d.Id(), err)
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("unable to get cluster '%s': %s", d.Id(), getErrorResponse(err)))
+			return resource.NonRetryableError(fmt.Errorf("unable to get cluster '%s': %v", d.Id(), err))
 		}
 
 		k.log.Debugf("cluster '%s' deletion in progress, deletionTimestamp: %s",
@@ -2,12 +2,15 @@ package kubermatic
 
 import (
 	"fmt"
+	"net/http"
 	"os"
+	"sort"
 	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/kubermatic/go-kubermatic/client/project"
 	"github.com/kubermatic/go-kubermatic/models"
@@ -611,6 +614,103 @@ func testAccCheckKubermaticClusterAWSBasic(n, keyID, keySecret, vpcID, nodeDC, k
 	}`, n, n, nodeDC, k8sVersion, keyID, keySecret, vpcID)
 }
 
+func TestAccKubermaticCluster_GCP_Basic(t *testing.T) {
+	var cluster models.Cluster
+	testName := randomTestName()
+
+	serviceAccount := os.Getenv(testEnvGCPServiceAccount)
+	nodeDC := os.Getenv(testEnvGCPNodeDC)
+	k8sVersion := os.Getenv(testEnvK8sVersion)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckForGCP(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubermaticClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckKubermaticClusterGCPBasic(testName, serviceAccount, nodeDC, k8sVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubermaticClusterExists(&cluster),
+					resource.TestCheckResourceAttr("kubermatic_cluster.acctest_cluster", "spec.0.cloud.0.gcp.#", "1"),
+					resource.TestCheckResourceAttr("kubermatic_cluster.acctest_cluster", "spec.0.cloud.0.gcp.0.service_account", serviceAccount),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubermaticClusterGCPBasic(n, serviceAccount, nodeDC, k8sVersion string) string {
+	return fmt.Sprintf(`
+	resource "kubermatic_project" "acctest_project" {
+		name = "%s"
+	}
+
+	resource "kubermatic_cluster" "acctest_cluster" {
+		name = "%s"
+		dc_name = "%s"
+		project_id = kubermatic_project.acctest_project.id
+
+		spec {
+			version = "%s"
+			cloud {
+				gcp {
+					service_account = "%s"
+				}
+			}
+		}
+	}`, n, n, nodeDC, k8sVersion, serviceAccount)
+}
+
+func TestAccKubermaticCluster_VSphere_Basic(t *testing.T) {
+	var cluster models.Cluster
+	testName := randomTestName()
+
+	username := os.Getenv(testEnvVSphereUsername)
+	password := os.Getenv(testEnvVSpherePassword)
+	nodeDC := os.Getenv(testEnvVSphereNodeDC)
+	k8sVersion := os.Getenv(testEnvK8sVersion)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckForVSphere(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubermaticClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckKubermaticClusterVSphereBasic(testName, username, password, nodeDC, k8sVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubermaticClusterExists(&cluster),
+					resource.TestCheckResourceAttr("kubermatic_cluster.acctest_cluster", "spec.0.cloud.0.vsphere.#", "1"),
+					resource.TestCheckResourceAttr("kubermatic_cluster.acctest_cluster", "spec.0.cloud.0.vsphere.0.username", username),
+					resource.TestCheckResourceAttr("kubermatic_cluster.acctest_cluster", "spec.0.cloud.0.vsphere.0.password", password),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubermaticClusterVSphereBasic(n, username, password, nodeDC, k8sVersion string) string {
+	return fmt.Sprintf(`
+	resource "kubermatic_project" "acctest_project" {
+		name = "%s"
+	}
+
+	resource "kubermatic_cluster" "acctest_cluster" {
+		name = "%s"
+		dc_name = "%s"
+		project_id = kubermatic_project.acctest_project.id
+
+		spec {
+			version = "%s"
+			cloud {
+				vsphere {
+					username = "%s"
+					password = "%s"
+				}
+			}
+		}
+	}`, n, n, nodeDC, k8sVersion, username, password)
+}
+
 func testAccCheckKubermaticClusterDestroy(s *terraform.State) error {
 	k := testAccProvider.Meta().(*kubermaticProviderMeta)
 
@@ -669,3 +769,201 @@ func testAccCheckKubermaticClusterExists(cluster *models.Cluster) resource.TestC
 		return nil
 	}
 }
+
+func TestIsVersionUpgrade(t *testing.T) {
+	cases := []struct {
+		Old            string
+		New            string
+		ExpectedOutput bool
+	}{
+		{"1.21.8", "1.22.5", true},
+		{"1.22.5", "1.22.5", false},
+		{"1.22.5", "1.21.8", false},
+		{"", "1.22.5", false},
+		{"1.22.5", "invalid", false},
+	}
+
+	for _, tc := range cases {
+		if output := isVersionUpgrade(tc.Old, tc.New); output != tc.ExpectedOutput {
+			t.Fatalf("Unexpected output for %s -> %s: want %v, got %v", tc.Old, tc.New, tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestIsClusterGone(t *testing.T) {
+	noUserInfo := project.NewGetClusterDefault(http.StatusInternalServerError)
+	noUserInfo.Payload = errorPayload("no userInfo in request")
+	otherError := project.NewGetClusterDefault(http.StatusInternalServerError)
+	otherError.Payload = errorPayload("internal error")
+
+	cases := []struct {
+		Err      error
+		Expected bool
+	}{
+		{Err: &apiError{code: http.StatusNotFound}, Expected: true},
+		{Err: &apiError{code: http.StatusInternalServerError, err: noUserInfo}, Expected: true},
+		{Err: &apiError{code: http.StatusInternalServerError, err: otherError}, Expected: false},
+		{Err: &apiError{code: http.StatusInternalServerError, err: &getThingDefault{code: http.StatusInternalServerError, Payload: errorPayload("no userInfo in request")}}, Expected: false},
+	}
+
+	for _, tc := range cases {
+		if got := isClusterGone(tc.Err); got != tc.Expected {
+			t.Errorf("%v: want %v, got %v", tc.Err, tc.Expected, got)
+		}
+	}
+}
+
+func TestNodeDeploymentUpgraded(t *testing.T) {
+	withKubelet := func(nd *models.NodeDeployment, version string) *models.NodeDeployment {
+		nd.Spec.Template = &models.NodeSpec{Versions: &models.NodeVersionInfo{Kubelet: version}}
+		return nd
+	}
+
+	cases := []struct {
+		Name           string
+		NodeDeployment *models.NodeDeployment
+		Expected       bool
+	}{
+		{
+			Name:           "upgraded",
+			NodeDeployment: withKubelet(testNodeDeployment(2, 2, 2, 2), "1.22.5"),
+			Expected:       true,
+		},
+		{
+			Name:           "old kubelet",
+			NodeDeployment: withKubelet(testNodeDeployment(2, 2, 2, 2), "1.21.8"),
+			Expected:       false,
+		},
+		{
+			Name:           "no versions",
+			NodeDeployment: testNodeDeployment(2, 2, 2, 2),
+			Expected:       false,
+		},
+		{
+			Name:           "replicas not updated",
+			NodeDeployment: withKubelet(testNodeDeployment(2, 3, 1, 2), "1.22.5"),
+			Expected:       false,
+		},
+		{
+			Name:           "replicas not ready",
+			NodeDeployment: withKubelet(testNodeDeployment(2, 2, 2, 1), "1.22.5"),
+			Expected:       false,
+		},
+		{
+			Name:           "no status",
+			NodeDeployment: withKubelet(&models.NodeDeployment{Spec: &models.NodeDeploymentSpec{Replicas: int32ToPtr(1)}}, "1.22.5"),
+			Expected:       false,
+		},
+		{
+			Name:           "scaled to zero",
+			NodeDeployment: withKubelet(&models.NodeDeployment{Spec: &models.NodeDeploymentSpec{Replicas: int32ToPtr(0)}}, "1.22.5"),
+			Expected:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := nodeDeploymentUpgraded(tc.NodeDeployment, "1.22.5"); got != tc.Expected {
+				t.Errorf("Want %v, got %v", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestClusterNotAvailableYet(t *testing.T) {
+	apiserverDown := &models.ClusterHealth{Apiserver: healthStatusProvisioning}
+	apiserverUp := &models.ClusterHealth{Apiserver: healthStatusUp}
+
+	cases := []struct {
+		Name     string
+		Err      error
+		Health   *models.ClusterHealth
+		Expected bool
+	}{
+		{
+			Name:     "kubeconfig not found without health",
+			Err:      fmt.Errorf("unable to get cluster kubeconfig 'abc': %w", &apiError{code: http.StatusNotFound}),
+			Expected: true,
+		},
+		{
+			Name:     "kubeconfig conflict while apiserver is provisioning",
+			Err:      fmt.Errorf("unable to get cluster kubeconfig 'abc': %w", &apiError{code: http.StatusConflict}),
+			Health:   apiserverDown,
+			Expected: true,
+		},
+		{
+			Name:     "kubeconfig not found while apiserver is up",
+			Err:      fmt.Errorf("unable to get cluster kubeconfig 'abc': %w", &apiError{code: http.StatusNotFound}),
+			Health:   apiserverUp,
+			Expected: false,
+		},
+		{
+			Name:     "forbidden",
+			Err:      fmt.Errorf("unable to get cluster kubeconfig 'abc': %w", &apiError{code: http.StatusForbidden}),
+			Expected: false,
+		},
+		{
+			Name:     "unauthorized",
+			Err:      fmt.Errorf("unable to get cluster 'abc' health: %w", &apiError{code: http.StatusUnauthorized}),
+			Expected: false,
+		},
+		{
+			Name:     "server error",
+			Err:      fmt.Errorf("unable to get cluster 'abc' health: %w", &apiError{code: http.StatusInternalServerError}),
+			Health:   apiserverDown,
+			Expected: false,
+		},
+		{
+			Name:     "invalid kubeconfig",
+			Err:      fmt.Errorf("unable to parse cluster kubeconfig 'abc': %v", "yaml: line 1: did not find expected key"),
+			Health:   apiserverDown,
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := clusterNotAvailableYet(tc.Err, tc.Health); got != tc.Expected {
+				t.Errorf("Want %v, got %v", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateClusterUpgrade(t *testing.T) {
+	upgrades := []*models.MasterVersion{
+		{Version: "1.22.5"},
+		{Version: "1.23.4", RestrictedByKubeletVersion: true},
+		nil,
+	}
+
+	cases := []struct {
+		Version     string
+		ExpectError string
+	}{
+		{Version: "1.22.5"},
+		{Version: "1.23.4", ExpectError: "available upgrades: [1.22.5], restricted by kubelet versions of the nodes: [1.23.4]"},
+		{Version: "1.24.0", ExpectError: "available upgrades: [1.22.5], restricted by kubelet versions of the nodes: [1.23.4]"},
+	}
+
+	for _, tc := range cases {
+		err := validateClusterUpgrade(upgrades, tc.Version)
+		if tc.ExpectError == "" && err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.Version, err)
+		}
+		if tc.ExpectError != "" && (err == nil || err.Error() != tc.ExpectError) {
+			t.Errorf("%s: want error '%s', got '%v'", tc.Version, tc.ExpectError, err)
+		}
+	}
+}
+
+func TestReadyComponents(t *testing.T) {
+	if diff := cmp.Diff(defaultClusterReadyComponents, readyComponents(schema.NewSet(schema.HashString, nil))); diff != "" {
+		t.Errorf("Unexpected default components: mismatch (-want +got):\n%s", diff)
+	}
+	components := readyComponents(schema.NewSet(schema.HashString, []interface{}{"etcd", "apiserver"}))
+	sort.Strings(components)
+	if diff := cmp.Diff([]string{"apiserver", "etcd"}, components); diff != "" {
+		t.Errorf("Unexpected components: mismatch (-want +got):\n%s", diff)
+	}
+}
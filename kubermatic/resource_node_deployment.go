@@ -1,12 +1,14 @@
 package kubermatic
 
 import (
+	"errors"
 	"fmt"
-	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/kubermatic/go-kubermatic/client/project"
 	"github.com/kubermatic/go-kubermatic/models"
 )
@@ -20,7 +22,17 @@ func resourceNodeDeployment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		CustomizeDiff: validateNodeSpecMatchesCluster(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			validateNodeSpecMatchesCluster(),
+			validateAutoscalingReplicas(),
+			validateOnlyOneOperatingSystemSpecified(),
+			setNodeDeploymentPendingUpdate(),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -48,6 +60,15 @@ func resourceNodeDeployment() *schema.Resource {
 				ForceNew:    true,
 				Description: "Node deployment name",
 			},
+			"cluster_ready_components": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Components of the cluster that have to be up before the node deployment is created, defaults to all but gatekeeper and MLA components",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(clusterHealthComponentNames(), false),
+				},
+			},
 			"spec": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
@@ -57,6 +78,27 @@ func resourceNodeDeployment() *schema.Resource {
 					Schema: nodeDeploymentSpecFields(),
 				},
 			},
+			"wait_for_ready": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Wait for all replicas to be updated and ready on create and update, otherwise return once the API accepted the node deployment",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Readiness of the node deployment, either ready or not_ready",
+			},
+			"restart_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, changing it restarts all machines with a rolling update",
+			},
+			"pending_update": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Planned update of the spec, either scale, rollout of new machines or in_place; empty once applied",
+			},
 			"creation_timestamp": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -96,6 +138,9 @@ func resourceNodeDeploymentCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	// waiting for the cluster and the node deployment shares the create timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
 	p := project.NewCreateNodeDeploymentParams()
 	p.SetProjectID(projectID)
 	p.SetDC(dc.Spec.Seed)
@@ -105,32 +150,19 @@ func resourceNodeDeploymentCreate(d *schema.ResourceData, m interface{}) error {
 		Spec: expandNodeDeploymentSpec(d.Get("spec").([]interface{})),
 	})
 
-	if err := waitClusterReady(k, d, projectID, dc.Spec.Seed, clusterID); err != nil {
+	if err := waitClusterReady(k, time.Until(deadline), projectID, dc.Spec.Seed, clusterID, readyComponents(d.Get("cluster_ready_components").(*schema.Set))); err != nil {
 		return fmt.Errorf("cluster is not ready: %v", err)
 	}
 
-	i := 0
-	for i < 3 {
-		r, err := k.client.Project.CreateNodeDeployment(p, k.auth)
-		if err != nil {
-			if i < 2 {
-				time.Sleep(5 * time.Second)
-				i++
-				continue
-			}
-			if e, ok := err.(*project.CreateNodeDeploymentDefault); ok && errorMessage(e.Payload) != "" {
-				return fmt.Errorf("unable to create node deployment: %s", errorMessage(e.Payload))
-			}
-
-			return fmt.Errorf("unable to create a node deployment: %v", getErrorResponse(err))
-		}
-		i = 3
-		d.SetId(r.Payload.ID)
+	r, err := k.client.Project.CreateNodeDeployment(p, k.auth)
+	if err != nil {
+		return fmt.Errorf("unable to create node deployment: %v", err)
+	}
+	d.SetId(r.Payload.ID)
 
-		if d.Get("spec.0.replicas").(int) > 0 {
-			if err := waitForNodeDeploymentRead(k, d.Timeout(schema.TimeoutCreate), projectID, dc.Spec.Seed, clusterID, r.Payload.ID); err != nil {
-				return err
-			}
+	if waitForNodeDeploymentRollout(d) {
+		if err := waitForNodeDeploymentReady(k, time.Until(deadline), projectID, dc.Spec.Seed, clusterID, r.Payload.ID, 0); err != nil {
+			return err
 		}
 	}
 
@@ -157,17 +189,12 @@ func resourceNodeDeploymentRead(d *schema.ResourceData, m interface{}) error {
 
 	r, err := k.client.Project.GetNodeDeployment(p, k.auth)
 	if err != nil {
-		if e, ok := err.(*project.GetNodeDeploymentDefault); ok && e.Code() == http.StatusNotFound {
-			k.log.Infof("removing node deployment '%s' from terraform state file, could not find the resource", d.Id())
+		if isResourceGone(err) {
+			k.log.Infof("removing node deployment '%s' from terraform state file: %v", d.Id(), err)
 			d.SetId("")
 			return nil
 		}
-		if _, ok := err.(*project.GetNodeDeploymentForbidden); ok {
-			k.log.Infof("removing node deployment '%s' from terraform state file, access forbidden", d.Id())
-			d.SetId("")
-			return nil
-		}
-		return fmt.Errorf("unable to get node deployment '%s': %s", d.Id(), getErrorResponse(err))
+		return fmt.Errorf("unable to get node deployment '%s': %v", d.Id(), err)
 	}
 
 	d.Set("cluster_id", clusterID)
@@ -178,6 +205,11 @@ func resourceNodeDeploymentRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("spec", flattenNodeDeploymentSpec(readNodeDeploymentPreservedValues(d), r.Payload.Spec))
 
+	d.Set("status", nodeDeploymentStatus(r.Payload))
+
+	// cleared, so the next update of the same type shows up in the plan again
+	d.Set("pending_update", "")
+
 	d.Set("creation_timestamp", r.Payload.CreationTimestamp.String())
 
 	d.Set("deletion_timestamp", r.Payload.DeletionTimestamp.String())
@@ -197,25 +229,61 @@ func resourceNodeDeploymentUpdate(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	p := project.NewPatchNodeDeploymentParams()
-	p.SetProjectID(projectID)
-	p.SetDC(dc.Spec.Seed)
-	p.SetClusterID(clusterID)
-	p.SetNodeDeploymentID(nodeDeplID)
-	p.SetPatch(models.NodeDeployment{
-		Spec: expandNodeDeploymentSpec(d.Get("spec").([]interface{})),
-	})
 
-	r, err := k.client.Project.PatchNodeDeployment(p, k.auth)
-	if err != nil {
-		if e, ok := err.(*project.PatchNodeDeploymentDefault); ok && errorMessage(e.Payload) != "" {
-			return fmt.Errorf("unable to update a node deployment: %v", errorMessage(e.Payload))
+	var specPatch map[string]interface{}
+	if d.HasChange("spec") {
+		old, new := d.GetChange("spec")
+		specPatch, err = createMergePatch(expandNodeDeploymentSpec(old.([]interface{})), expandNodeDeploymentSpec(new.([]interface{})))
+		if err != nil {
+			return fmt.Errorf("unable to create patch for node deployment '%s': %v", nodeDeplID, err)
+		}
+	}
+	restart := nodeDeploymentRestartRequested(d.HasChange, d.Get)
+
+	// in place updates like autoscaling bounds don't change the generation,
+	// other updates are waited for until the controller observed a generation
+	// past the one it observed before the update
+	var generation int64
+	if restart || (specPatch != nil && nodeDeploymentUpdateType(d.HasChange) != nodeDeploymentUpdateInPlace) {
+		nd, err := getNodeDeployment(k, projectID, dc.Spec.Seed, clusterID, nodeDeplID)
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("unable to update a node deployment: %v", getErrorResponse(err))
+		generation = nodeDeploymentObservedGeneration(nd) + 1
 	}
 
-	if d.Get("spec.0.replicas").(int) > 0 {
-		if err := waitForNodeDeploymentRead(k, d.Timeout(schema.TimeoutCreate), projectID, dc.Spec.Seed, clusterID, r.Payload.ID); err != nil {
+	if specPatch != nil {
+		p := project.NewPatchNodeDeploymentParams()
+		p.SetProjectID(projectID)
+		p.SetDC(dc.Spec.Seed)
+		p.SetClusterID(clusterID)
+		p.SetNodeDeploymentID(nodeDeplID)
+		p.SetPatch(map[string]interface{}{
+			"spec": specPatch,
+		})
+
+		k.log.Debugf("patching node deployment '%s' (%s): %+v", nodeDeplID, d.Get("pending_update"), specPatch)
+		if _, err := k.client.Project.PatchNodeDeployment(p, k.auth); err != nil {
+			return fmt.Errorf("unable to update a node deployment: %v", err)
+		}
+	}
+
+	if restart {
+		p := project.NewRestartNodeDeploymentParams()
+		p.SetProjectID(projectID)
+		p.SetDC(dc.Spec.Seed)
+		p.SetClusterID(clusterID)
+		p.SetNodeDeploymentID(nodeDeplID)
+
+		k.log.Debugf("restarting node deployment '%s'", nodeDeplID)
+		if _, err := k.client.Project.RestartNodeDeployment(p, k.auth); err != nil {
+			return fmt.Errorf("unable to restart node deployment '%s': %v", nodeDeplID, err)
+		}
+	}
+
+	updated := specPatch != nil || restart
+	if updated && waitForNodeDeploymentRollout(d) {
+		if err := waitForNodeDeploymentReady(k, d.Timeout(schema.TimeoutUpdate), projectID, dc.Spec.Seed, clusterID, nodeDeplID, generation); err != nil {
 			return err
 		}
 	}
@@ -223,25 +291,71 @@ func resourceNodeDeploymentUpdate(d *schema.ResourceData, m interface{}) error {
 	return resourceNodeDeploymentRead(d, m)
 }
 
-func waitForNodeDeploymentRead(k *kubermaticProviderMeta, timeout time.Duration, projectID, seedDC, clusterID, id string) error {
-	return resource.Retry(timeout, func() *resource.RetryError {
-		p := project.NewGetNodeDeploymentParams()
-		p.SetProjectID(projectID)
-		p.SetClusterID(clusterID)
-		p.SetDC(seedDC)
-		p.SetNodeDeploymentID(id)
+func getNodeDeployment(k *kubermaticProviderMeta, projectID, seedDC, clusterID, id string) (*models.NodeDeployment, error) {
+	p := project.NewGetNodeDeploymentParams()
+	p.SetProjectID(projectID)
+	p.SetDC(seedDC)
+	p.SetClusterID(clusterID)
+	p.SetNodeDeploymentID(id)
 
-		r, err := k.client.Project.GetNodeDeployment(p, k.auth)
-		if err != nil {
-			return resource.RetryableError(fmt.Errorf("unable to get node deployment %v", err))
+	r, err := k.client.Project.GetNodeDeployment(p, k.auth)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get node deployment '%s': %v", id, err)
+	}
+	return r.Payload, nil
+}
+
+// nodeDeploymentRestartRequested returns true if restart_trigger changed to a
+// non-empty value. Removing the trigger doesn't restart the machines.
+func nodeDeploymentRestartRequested(hasChange func(key string) bool, get func(key string) interface{}) bool {
+	return hasChange("restart_trigger") && get("restart_trigger").(string) != ""
+}
+
+// waitForNodeDeploymentRollout returns false for paused node deployments, as
+// waiting for their replicas to be updated would time out.
+func waitForNodeDeploymentRollout(d *schema.ResourceData) bool {
+	return d.Get("wait_for_ready").(bool) && !d.Get("spec.0.paused").(bool)
+}
+
+const (
+	nodeDeploymentUpdateScale   = "scale"
+	nodeDeploymentUpdateRollout = "rollout"
+	nodeDeploymentUpdateInPlace = "in_place"
+)
+
+// nodeDeploymentRolloutFields are spec fields, that replace all machines when changed.
+var nodeDeploymentRolloutFields = []string{
+	"spec.0.template",
+	"spec.0.dynamic_config",
+}
+
+// setNodeDeploymentPendingUpdate announces in the plan, whether an update of
+// the spec scales the node deployment or rolls out new machines.
+func setNodeDeploymentPendingUpdate() schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
 		}
+		if nodeDeploymentRestartRequested(d.HasChange, d.Get) {
+			return d.SetNew("pending_update", nodeDeploymentUpdateRollout)
+		}
+		if !d.HasChange("spec") {
+			return nil
+		}
+		return d.SetNew("pending_update", nodeDeploymentUpdateType(d.HasChange))
+	}
+}
 
-		if r.Payload.Status.ReadyReplicas < *r.Payload.Spec.Replicas {
-			k.log.Debugf("waiting for node deployment '%s' to be ready, %+v", id, r.Payload.Status)
-			return resource.RetryableError(fmt.Errorf("waiting for node deployment '%s' to be ready", id))
+func nodeDeploymentUpdateType(hasChange func(key string) bool) string {
+	for _, key := range nodeDeploymentRolloutFields {
+		if hasChange(key) {
+			return nodeDeploymentUpdateRollout
 		}
-		return nil
-	})
+	}
+	if hasChange("spec.0.replicas") {
+		return nodeDeploymentUpdateScale
+	}
+	return nodeDeploymentUpdateInPlace
 }
 
 func resourceNodeDeploymentDelete(d *schema.ResourceData, m interface{}) error {
@@ -262,8 +376,11 @@ func resourceNodeDeploymentDelete(d *schema.ResourceData, m interface{}) error {
 
 	_, err = k.client.Project.DeleteNodeDeployment(p, k.auth)
 	if err != nil {
-		// TODO: check if not found
-		return fmt.Errorf("unable to delete node deployment '%s': %s", d.Id(), getErrorResponse(err))
+		if errors.Is(err, errNotFound) {
+			k.log.Debugf("node deployment '%s' has already been deleted: %v", d.Id(), err)
+			return nil
+		}
+		return fmt.Errorf("unable to delete node deployment '%s': %v", d.Id(), err)
 	}
 
 	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
@@ -275,12 +392,12 @@ func resourceNodeDeploymentDelete(d *schema.ResourceData, m interface{}) error {
 
 		r, err := k.client.Project.GetNodeDeployment(p, k.auth)
 		if err != nil {
-			if e, ok := err.(*project.GetNodeDeploymentDefault); ok && e.Code() == http.StatusNotFound {
-				k.log.Debugf("node deployment '%s' has been destroyed, returned http code: %d", d.Id(), e.Code())
+			if errors.Is(err, errNotFound) {
+				k.log.Debugf("node deployment '%s' has been destroyed: %v", d.Id(), err)
 				d.SetId("")
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("unable to get node deployment '%s': %s", d.Id(), getErrorResponse(err)))
+			return resource.NonRetryableError(fmt.Errorf("unable to get node deployment '%s': %v", d.Id(), err))
 		}
 
 		k.log.Debugf("node deployment '%s' deletion in progress, deletionTimestamp: %s",
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/kubermatic/go-kubermatic/client/project"
 	"github.com/kubermatic/go-kubermatic/models"
@@ -319,6 +320,83 @@ func testAccCheckKubermaticNodeDeploymentAzureBasic(n, clientID, clientSecret, t
 	}`, n, n, nodeDC, k8sVersion, clientID, clientSecret, tenantID, subscID, n, nodeSize, k8sVersion)
 }
 
+func TestAccKubermaticNodeDeployment_GCP_Basic(t *testing.T) {
+	var nodedepl models.NodeDeployment
+	testName := randomTestName()
+
+	serviceAccount := os.Getenv(testEnvGCPServiceAccount)
+	nodeDC := os.Getenv(testEnvGCPNodeDC)
+	machineType := os.Getenv(testEnvGCPMachineType)
+	zone := os.Getenv(testEnvGCPZone)
+	k8sVersion := os.Getenv(testEnvK8sVersion)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckForGCP(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubermaticNodeDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckKubermaticNodeDeploymentGCPBasic(testName, serviceAccount, nodeDC, machineType, zone, k8sVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubermaticNodeDeploymentExists("kubermatic_node_deployment.acctest_nd", &nodedepl),
+					resource.TestCheckResourceAttr("kubermatic_node_deployment.acctest_nd", "spec.0.template.0.cloud.0.gcp.0.machine_type", machineType),
+					resource.TestCheckResourceAttr("kubermatic_node_deployment.acctest_nd", "spec.0.template.0.cloud.0.gcp.0.zone", zone),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubermaticNodeDeploymentGCPBasic(n, serviceAccount, nodeDC, machineType, zone, k8sVersion string) string {
+	return fmt.Sprintf(`
+	resource "kubermatic_project" "acctest_project" {
+		name = "%s"
+	}
+
+	resource "kubermatic_cluster" "acctest_cluster" {
+		name = "%s"
+		dc_name = "%s"
+		project_id = kubermatic_project.acctest_project.id
+
+		spec {
+			version = "%s"
+			cloud {
+				gcp {
+					service_account = "%s"
+				}
+			}
+		}
+	}
+
+	resource "kubermatic_node_deployment" "acctest_nd" {
+		project_id = kubermatic_project.acctest_project.id
+		dc_name = "%s"
+		cluster_id = kubermatic_cluster.acctest_cluster.id
+		name = "%s"
+		spec {
+			replicas = 1
+			template {
+				cloud {
+					gcp {
+						machine_type = "%s"
+						zone = "%s"
+						disk_size = 25
+						disk_type = "pd-standard"
+					}
+				}
+				operating_system {
+					ubuntu {
+						dist_upgrade_on_boot = false
+					}
+				}
+				versions {
+					kubelet = "%s"
+				}
+			}
+		}
+	}`, n, n, nodeDC, k8sVersion, serviceAccount, nodeDC, n, machineType, zone, k8sVersion)
+}
+
 func TestAccKubermaticNodeDeployment_AWS_Basic(t *testing.T) {
 	var nodedepl models.NodeDeployment
 	testName := randomTestName()
@@ -443,3 +521,127 @@ func testAccCheckKubermaticNodeDeploymentExists(n string, rec *models.NodeDeploy
 		return nil
 	}
 }
+
+func TestNodeDeploymentUpdateType(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Changed  []string
+		Expected string
+	}{
+		{
+			Name:     "replicas",
+			Changed:  []string{"spec.0.replicas"},
+			Expected: nodeDeploymentUpdateScale,
+		},
+		{
+			Name:     "template",
+			Changed:  []string{"spec.0.template"},
+			Expected: nodeDeploymentUpdateRollout,
+		},
+		{
+			Name:     "replicas and template",
+			Changed:  []string{"spec.0.replicas", "spec.0.template"},
+			Expected: nodeDeploymentUpdateRollout,
+		},
+		{
+			Name:     "dynamic config",
+			Changed:  []string{"spec.0.dynamic_config"},
+			Expected: nodeDeploymentUpdateRollout,
+		},
+		{
+			Name:     "other",
+			Expected: nodeDeploymentUpdateInPlace,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			hasChange := func(key string) bool {
+				for _, c := range tc.Changed {
+					if c == key {
+						return true
+					}
+				}
+				return false
+			}
+			if got := nodeDeploymentUpdateType(hasChange); got != tc.Expected {
+				t.Errorf("Want %s, got %s", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestNodeDeploymentRestartRequested(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Changed  bool
+		Trigger  string
+		Expected bool
+	}{
+		{
+			Name:     "changed",
+			Changed:  true,
+			Trigger:  "2022-03-01T10:00:00Z",
+			Expected: true,
+		},
+		{
+			Name:    "unchanged",
+			Trigger: "2022-03-01T10:00:00Z",
+		},
+		{
+			Name:    "removed",
+			Changed: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			hasChange := func(key string) bool {
+				return key == "restart_trigger" && tc.Changed
+			}
+			get := func(key string) interface{} {
+				return tc.Trigger
+			}
+			if got := nodeDeploymentRestartRequested(hasChange, get); got != tc.Expected {
+				t.Errorf("Want %v, got %v", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestNodeDeploymentPendingUpdateOfConsecutiveUpdates(t *testing.T) {
+	r := &schema.Resource{
+		Schema:        resourceNodeDeployment().Schema,
+		CustomizeDiff: setNodeDeploymentPendingUpdate(),
+	}
+	config := func(replicas int) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"spec": []interface{}{
+				map[string]interface{}{"replicas": replicas},
+			},
+		})
+	}
+	apply := func(state *terraform.InstanceState, replicas int) (*terraform.InstanceState, string) {
+		diff, err := r.Diff(state, config(replicas), nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var planned string
+		if a, ok := diff.Attributes["pending_update"]; ok {
+			planned = a.New
+		}
+		state = state.MergeDiff(diff)
+		// as left by read after the update is applied
+		state.Attributes["pending_update"] = ""
+		return state, planned
+	}
+
+	state, _ := apply(&terraform.InstanceState{ID: "nd"}, 1)
+	for _, replicas := range []int{2, 3} {
+		var planned string
+		state, planned = apply(state, replicas)
+		if planned != nodeDeploymentUpdateScale {
+			t.Errorf("Scaling to %d replicas: want pending_update '%s' in the plan, got '%s'", replicas, nodeDeploymentUpdateScale, planned)
+		}
+	}
+}
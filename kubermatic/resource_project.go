@@ -1,8 +1,8 @@
 package kubermatic
 
 import (
+	"errors"
 	"fmt"
-	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -29,6 +29,12 @@ func resourceProject() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -88,6 +94,9 @@ func projectUsersSchema() *schema.Resource {
 
 func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 	k := m.(*kubermaticProviderMeta)
+	// waiting for the project and updating its users share the create timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
 	p := project.NewCreateProjectParams()
 
 	p.Body.Name = d.Get("name").(string)
@@ -101,10 +110,7 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 
 	r, err := k.client.Project.CreateProject(p, k.auth)
 	if err != nil {
-		if e, ok := err.(*project.CreateProjectUnauthorized); ok {
-			return fmt.Errorf("invalid or expired token. Error: %s", e.Error())
-		}
-		return fmt.Errorf("error when creating a project: %s", getErrorResponse(err))
+		return fmt.Errorf("error when creating a project: %v", err)
 	}
 	d.SetId(r.Payload.ID)
 
@@ -120,7 +126,7 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 			p := project.NewGetProjectParams()
 			r, err := k.client.Project.GetProject(p.WithProjectID(id), k.auth)
 			if err != nil {
-				if e, ok := err.(*project.GetProjectDefault); ok && (e.Code() == http.StatusForbidden || e.Code() == http.StatusNotFound) {
+				if isResourceGone(err) {
 					return r, projectInactive, fmt.Errorf("project not ready: %v", err)
 				}
 				return nil, "", err
@@ -128,7 +134,7 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 			k.log.Debugf("creating project '%s', currently in '%s' state", id, r.Payload.Status)
 			return r, projectActive, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    time.Until(deadline),
 		MinTimeout: 5 * retryTimeout,
 		Delay:      5 * requestDelay,
 	}
@@ -138,7 +144,7 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("error while waiting for project '%s' to be created: %s", id, err)
 	}
 
-	if err := kubermaticProjectUpdateUsers(k, d); err != nil {
+	if err := kubermaticProjectUpdateUsers(k, d, time.Until(deadline)); err != nil {
 		return fmt.Errorf("error updating project's users: %v", err)
 	}
 
@@ -151,18 +157,14 @@ func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
 
 	r, err := k.client.Project.GetProject(p.WithProjectID(d.Id()), k.auth)
 	if err != nil {
-		if e, ok := err.(*project.GetProjectDefault); ok && (e.Code() == http.StatusForbidden || e.Code() == http.StatusNotFound) {
+		if isResourceGone(err) {
 			// remove a project from terraform state file that a user does not have access or does not exist
-			k.log.Infof("removing project '%s' from terraform state file, code '%d' has been returned", d.Id(), e.Code())
+			k.log.Infof("removing project '%s' from terraform state file: %v", d.Id(), err)
 			d.SetId("")
 			return nil
-
-		}
-		if e, ok := err.(*project.GetProjectUnauthorized); ok {
-			return fmt.Errorf("invalid or expired token. Error: %s", e.Error())
 		}
 
-		return fmt.Errorf("unable to get project '%s': %s", d.Id(), getErrorResponse(err))
+		return fmt.Errorf("unable to get project '%s': %v", d.Id(), err)
 	}
 
 	if err := d.Set("labels", r.Payload.Labels); err != nil {
@@ -173,7 +175,7 @@ func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("creation_timestamp", r.Payload.CreationTimestamp.String())
 	d.Set("deletion_timestamp", r.Payload.DeletionTimestamp.String())
 
-	users, err := kubermaticProjectPersistedUsers(k, d.Id())
+	users, err := kubermaticProjectPersistedUsers(k, d.Id(), d.Timeout(schema.TimeoutRead))
 	if err != nil {
 		return err
 	}
@@ -221,11 +223,12 @@ func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
 
 	_, err := k.client.Project.UpdateProject(p.WithProjectID(d.Id()), k.auth)
 	if err != nil {
-		return fmt.Errorf("unable to update project '%s': %s", d.Id(), getErrorResponse(err))
+		return fmt.Errorf("unable to update project '%s': %v", d.Id(), err)
 	}
+	k.cache.invalidate(projectCacheKey(d.Id()))
 
 	if d.HasChange("user") {
-		if err := kubermaticProjectUpdateUsers(k, d); err != nil {
+		if err := kubermaticProjectUpdateUsers(k, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("error updating project's users: %v", err)
 		}
 	}
@@ -233,13 +236,13 @@ func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
 	return resourceProjectRead(d, m)
 }
 
-func kubermaticProjectUpdateUsers(k *kubermaticProviderMeta, d *schema.ResourceData) error {
+func kubermaticProjectUpdateUsers(k *kubermaticProviderMeta, d *schema.ResourceData, timeout time.Duration) error {
 	curUser, err := kubermaticProjectCurrentUser(k)
 	if err != nil {
 		return err
 	}
 
-	persistedUsers, err := kubermaticProjectPersistedUsers(k, d.Id())
+	persistedUsers, err := kubermaticProjectPersistedUsers(k, d.Id(), timeout)
 	if err != nil {
 		return err
 	}
@@ -283,9 +286,6 @@ func kubermaticProjectEditUser(k *kubermaticProviderMeta, pid string, u *models.
 	p.SetBody(u)
 	_, err := k.client.Users.EditUserInProject(p, k.auth)
 	if err != nil {
-		if e, ok := err.(*users.EditUserInProjectDefault); ok && errorMessage(e.Payload) != "" {
-			return fmt.Errorf("edit user in project errored: %s", errorMessage(e.Payload))
-		}
 		return fmt.Errorf("edit user in project errored: %v", err)
 	}
 	return nil
@@ -297,9 +297,6 @@ func kubermaticProjectDeleteUser(k *kubermaticProviderMeta, pid, uid string) err
 	p.SetUserID(uid)
 	_, err := k.client.Users.DeleteUserFromProject(p, k.auth)
 	if err != nil {
-		if e, ok := err.(*users.DeleteUserFromProjectDefault); ok && errorMessage(e.Payload) != "" {
-			return fmt.Errorf("delete user from project: %s", errorMessage(e.Payload))
-		}
 		return fmt.Errorf("delete user from project: %v", err)
 	}
 	return nil
@@ -310,9 +307,6 @@ func kubermaticProjectAddUser(k *kubermaticProviderMeta, pid string, u *models.U
 	p.SetProjectID(pid)
 	p.SetBody(u)
 	if _, err := k.client.Users.AddUserToProject(p, k.auth); err != nil {
-		if e, ok := err.(*users.AddUserToProjectDefault); ok && errorMessage(e.Payload) != "" {
-			return fmt.Errorf("add user to project: %s", errorMessage(e.Payload))
-		}
 		return fmt.Errorf("add user to project: %v", err)
 	}
 	return nil
@@ -321,15 +315,12 @@ func kubermaticProjectAddUser(k *kubermaticProviderMeta, pid string, u *models.U
 func kubermaticProjectCurrentUser(k *kubermaticProviderMeta) (*models.User, error) {
 	r, err := k.client.Users.GetCurrentUser(users.NewGetCurrentUserParams(), k.auth)
 	if err != nil {
-		if e, ok := err.(*users.GetCurrentUserDefault); ok && errorMessage(e.Payload) != "" {
-			return nil, fmt.Errorf("get current user errored: %s", errorMessage(e.Payload))
-		}
 		return nil, fmt.Errorf("get current user errored: %v", err)
 	}
 	return r.Payload, nil
 }
 
-func kubermaticProjectPersistedUsers(k *kubermaticProviderMeta, id string) (map[string]models.User, error) {
+func kubermaticProjectPersistedUsers(k *kubermaticProviderMeta, id string, timeout time.Duration) (map[string]models.User, error) {
 	listStateConf := &resource.StateChangeConf{
 		Pending: []string{
 			usersUnavailable,
@@ -344,7 +335,7 @@ func kubermaticProjectPersistedUsers(k *kubermaticProviderMeta, id string) (map[
 			r, err := k.client.Users.GetUsersForProject(p, k.auth)
 			if err != nil {
 				// wait for the RBACs
-				if _, ok := err.(*users.GetUsersForProjectForbidden); ok {
+				if errors.Is(err, errForbidden) {
 					return r, usersUnavailable, nil
 				}
 				return nil, usersUnavailable, fmt.Errorf("get users for project error: %v", err)
@@ -355,7 +346,7 @@ func kubermaticProjectPersistedUsers(k *kubermaticProviderMeta, id string) (map[
 			}
 			return ret, usersReady, nil
 		},
-		Timeout: 10 * time.Second,
+		Timeout: timeout,
 		Delay:   5 * requestDelay,
 	}
 
@@ -389,19 +380,22 @@ func resourceProjectDelete(d *schema.ResourceData, m interface{}) error {
 	p := project.NewDeleteProjectParams()
 	_, err := k.client.Project.DeleteProject(p.WithProjectID(d.Id()), k.auth)
 	if err != nil {
-		if e, ok := err.(*project.DeleteProjectUnauthorized); ok {
-			return fmt.Errorf("invalid or expired token. Error: %s", e.Error())
+		if errors.Is(err, errNotFound) {
+			k.log.Infof("project '%s' has already been deleted: %v", d.Id(), err)
+			k.cache.invalidate(projectCacheKey(d.Id()))
+			return nil
 		}
-		return fmt.Errorf("unable to delete project '%s': %s", d.Id(), getErrorResponse(err))
+		return fmt.Errorf("unable to delete project '%s': %v", d.Id(), err)
 	}
+	k.cache.invalidate(projectCacheKey(d.Id()))
 
 	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		p := project.NewGetProjectParams()
 		r, err := k.client.Project.GetProject(p.WithProjectID(d.Id()), k.auth)
 		if err != nil {
-			e, ok := err.(*project.GetProjectDefault)
-			if ok && (e.Code() == http.StatusForbidden || e.Code() == http.StatusNotFound) {
-				k.log.Debugf("project '%s' has been destroyed, returned http code: %d", d.Id(), e.Code())
+			// the project has been deleted, so access to it may be forbidden already
+			if isResourceGone(err) {
+				k.log.Debugf("project '%s' has been destroyed: %v", d.Id(), err)
 				return nil
 			}
 			return resource.NonRetryableError(err)
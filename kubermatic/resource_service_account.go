@@ -1,6 +1,7 @@
 package kubermatic
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -26,6 +27,9 @@ func resourceServiceAccount() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -83,9 +87,6 @@ func resourceServiceAccountCreate(d *schema.ResourceData, m interface{}) error {
 	})
 	r, err := k.client.Serviceaccounts.AddServiceAccountToProject(p, k.auth)
 	if err != nil {
-		if e, ok := err.(*serviceaccounts.AddServiceAccountToProjectDefault); ok && errorMessage(e.Payload) != "" {
-			return fmt.Errorf("unable to create service account: %s", errorMessage(e.Payload))
-		}
 		return fmt.Errorf("unable to create service account: %v", err)
 	}
 	d.SetId(kubermaticServiceAccountMakeID(d.Get("project_id").(string), r.Payload.ID))
@@ -101,7 +102,7 @@ func resourceServiceAccountRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	all, err := kubermaticServiceAccountList(k, projectID)
+	all, err := kubermaticServiceAccountList(k, projectID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
 		return err
 	}
@@ -124,7 +125,7 @@ func resourceServiceAccountRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func kubermaticServiceAccountList(k *kubermaticProviderMeta, projectID string) ([]*models.ServiceAccount, error) {
+func kubermaticServiceAccountList(k *kubermaticProviderMeta, projectID string, timeout time.Duration) ([]*models.ServiceAccount, error) {
 	listStateConf := &resource.StateChangeConf{
 		Pending: []string{
 			serviceAccountUnavailable,
@@ -138,14 +139,14 @@ func kubermaticServiceAccountList(k *kubermaticProviderMeta, projectID string) (
 			s, err := k.client.Serviceaccounts.ListServiceAccounts(p, k.auth)
 			if err != nil {
 				// wait for the RBACs
-				if _, ok := err.(*serviceaccounts.ListServiceAccountsForbidden); ok {
+				if errors.Is(err, errForbidden) {
 					return s, usersUnavailable, nil
 				}
 				return nil, serviceAccountUnavailable, fmt.Errorf("can not get service accounts: %v", err)
 			}
 			return s, serviceAccountReady, nil
 		},
-		Timeout: 20 * time.Second,
+		Timeout: timeout,
 		Delay:   requestDelay,
 	}
 
@@ -178,9 +179,6 @@ func resourceServiceAccountUpdate(d *schema.ResourceData, m interface{}) error {
 	// })
 	// _, err = k.client.Serviceaccounts.UpdateServiceAccount(p, k.auth)
 	// if err != nil {
-	// 	if e, ok := err.(*serviceaccounts.UpdateServiceAccountDefault); ok && errorMessage(e.Payload) != "" {
-	// 		return fmt.Errorf("unable to update service account: %s", errorMessage(e.Payload))
-	// 	}
 	// 	return fmt.Errorf("unable to update service account: %v", err)
 	// }
 	return resourceServiceAccountRead(d, m)
@@ -199,8 +197,9 @@ func resourceServiceAccountDelete(d *schema.ResourceData, m interface{}) error {
 	p.SetServiceAccountID(serviceAccountID)
 	_, err = k.client.Serviceaccounts.DeleteServiceAccount(p, k.auth)
 	if err != nil {
-		if e, ok := err.(*serviceaccounts.DeleteServiceAccountDefault); ok && errorMessage(e.Payload) != "" {
-			return fmt.Errorf("unable to delete service account: %s", errorMessage(e.Payload))
+		if errors.Is(err, errNotFound) {
+			k.log.Debugf("service account '%s' has already been deleted: %v", d.Id(), err)
+			return nil
 		}
 		return fmt.Errorf("unable to delete service account: %v", err)
 	}
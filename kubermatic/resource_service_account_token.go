@@ -1,6 +1,7 @@
 package kubermatic
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -25,6 +26,9 @@ func resourceServiceAccountToken() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_account_id": {
@@ -86,9 +90,6 @@ func resourceServiceAccountTokenCreate(d *schema.ResourceData, m interface{}) er
 	})
 	r, err := k.client.Tokens.AddTokenToServiceAccount(p, k.auth)
 	if err != nil {
-		if e, ok := err.(*tokens.AddTokenToServiceAccountDefault); ok && errorMessage(e.Payload) != "" {
-			return fmt.Errorf("unable to create token: %s", errorMessage(e.Payload))
-		}
 		return fmt.Errorf("unable to create token: %v", err)
 	}
 	d.Set("token", r.Payload.Token)
@@ -116,14 +117,14 @@ func resourceServiceAccountTokenRead(d *schema.ResourceData, m interface{}) erro
 			t, err := k.client.Tokens.ListServiceAccountTokens(p, k.auth)
 			if err != nil {
 				// wait for the RBACs
-				if _, ok := err.(*tokens.ListServiceAccountTokensForbidden); ok {
+				if errors.Is(err, errForbidden) {
 					return t, serviceAccountTokenUnavailable, nil
 				}
 				return nil, serviceAccountTokenUnavailable, err
 			}
 			return t, serviceAccountTokenReady, nil
 		},
-		Timeout: 30 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutRead),
 		Delay:   requestDelay,
 	}
 
@@ -167,9 +168,6 @@ func resourceServiceAccountTokenUpdate(d *schema.ResourceData, m interface{}) er
 	// p.SetBody([]uint8(fmt.Sprintf(`{name:"%s"}`, d.Get("name").(string))))
 	// _, err = k.client.Tokens.PatchServiceAccountToken(p, k.auth)
 	// if err != nil {
-	// 	if e, ok := err.(*tokens.PatchServiceAccountTokenDefault); ok && errorMessage(e.Payload) != "" {
-	// 		return fmt.Errorf("failed to update token: %s", errorMessage(e.Payload))
-	// 	}
 	// 	return fmt.Errorf("failed to update token: %v", err)
 	// }
 	return resourceServiceAccountTokenRead(d, m)
@@ -189,8 +187,9 @@ func resourceServiceAccountTokenDelete(d *schema.ResourceData, m interface{}) er
 	p.SetTokenID(tokenID)
 	_, err = k.client.Tokens.DeleteServiceAccountToken(p, k.auth)
 	if err != nil {
-		if e, ok := err.(*tokens.DeleteServiceAccountTokenDefault); ok && errorMessage(e.Payload) != "" {
-			return fmt.Errorf("failed to delete token: %s", errorMessage(e.Payload))
+		if errors.Is(err, errNotFound) {
+			k.log.Debugf("token '%s' has already been deleted: %v", d.Id(), err)
+			return nil
 		}
 		return fmt.Errorf("failed to delete token: %v", err)
 	}
@@ -1,8 +1,8 @@
 package kubermatic
 
 import (
+	"errors"
 	"fmt"
-	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -48,7 +48,7 @@ func TestAccKubermaticToken_Basic(t *testing.T) {
 func testAccCheckKubermaticServiceAccountTokenDestroy(s *terraform.State) error {
 	token, err := testAccKubermaticServiceAccountFetchToken(s)
 	if err != nil {
-		if e, ok := err.(*tokens.ListServiceAccountTokensDefault); ok && e.Code() == http.StatusNotFound {
+		if errors.Is(err, errNotFound) {
 			return nil
 		}
 		return err
@@ -95,7 +95,7 @@ func testAccKubermaticServiceAccountFetchToken(s *terraform.State) (*models.Publ
 	p.SetServiceAccountID(serviceAccountID)
 	r, err := k.client.Tokens.ListServiceAccountTokens(p, k.auth)
 	if err != nil {
-		if _, ok := err.(*tokens.ListServiceAccountTokensForbidden); ok {
+		if errors.Is(err, errForbidden) {
 			return nil, nil
 		}
 		return nil, err
@@ -1,6 +1,7 @@
 package kubermatic
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -25,6 +26,9 @@ func resourceSSHKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -64,7 +68,7 @@ func resourceSSHKeyCreate(d *schema.ResourceData, m interface{}) error {
 	}
 	created, err := k.client.Project.CreateSSHKey(p, k.auth)
 	if err != nil {
-		return fmt.Errorf("unable to create SSH key: %s", getErrorResponse(err))
+		return fmt.Errorf("unable to create SSH key: %v", err)
 	}
 	d.SetId(created.Payload.ID)
 	return resourceSSHKeyRead(d, m)
@@ -86,14 +90,14 @@ func resourceSSHKeyRead(d *schema.ResourceData, m interface{}) error {
 			k, err := k.client.Project.ListSSHKeys(p, k.auth)
 			if err != nil {
 				// wait for the RBACs
-				if _, ok := err.(*project.ListSSHKeysForbidden); ok {
+				if errors.Is(err, errForbidden) {
 					return k, sshUnavailable, nil
 				}
 				return nil, sshUnavailable, fmt.Errorf("can not get ssh keys: %v", err)
 			}
 			return k, sshReady, nil
 		},
-		Timeout: 20 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutRead),
 		Delay:   requestDelay,
 	}
 
@@ -127,7 +131,11 @@ func resourceSSHKeyDelete(d *schema.ResourceData, m interface{}) error {
 	p.SetSSHKeyID(d.Id())
 	_, err := k.client.Project.DeleteSSHKey(p, k.auth)
 	if err != nil {
-		return fmt.Errorf("unable to delete SSH key: %s", getErrorResponse(err))
+		if errors.Is(err, errNotFound) {
+			k.log.Debugf("SSH key '%s' has already been deleted: %v", d.Id(), err)
+			return nil
+		}
+		return fmt.Errorf("unable to delete SSH key: %v", err)
 	}
 	return nil
 }
@@ -2,7 +2,6 @@ package kubermatic
 
 import (
 	"fmt"
-	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -63,10 +62,7 @@ func testAccCheckKubermaticSSHKeyDestroy(s *terraform.State) error {
 		sshkeys, err := k.client.Project.ListSSHKeys(p, k.auth)
 		if err != nil {
 			// API returns 403 if project doesn't exist.
-			if _, ok := err.(*project.ListSSHKeysForbidden); ok {
-				continue
-			}
-			if e, ok := err.(*project.ListSSHKeysDefault); ok && e.Code() == http.StatusNotFound {
+			if isResourceGone(err) {
 				continue
 			}
 			return fmt.Errorf("check destroy: %v", err)
@@ -0,0 +1,220 @@
+package kubermatic
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultMaxRetries is the number of times a failed request is retried
+	defaultMaxRetries = 5
+	// defaultRetryMaxWait is the longest time to wait between two attempts
+	defaultRetryMaxWait = 30 * time.Second
+	// retryMinWait is the wait before the first retry, doubled with every attempt
+	retryMinWait = 500 * time.Millisecond
+)
+
+// retryRoundTripper retries requests failing with 429, 5xx or connection errors
+// using exponential backoff with jitter. Retry-After headers are honoured.
+// Non-idempotent requests are only retried when they were not processed.
+type retryRoundTripper struct {
+	next       http.RoundTripper
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+	log        *zap.SugaredLogger
+	// sleep waits for the given duration or until the context is done
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newRetryRoundTripper(next http.RoundTripper, maxRetries int, maxWait time.Duration, log *zap.SugaredLogger) *retryRoundTripper {
+	return &retryRoundTripper{
+		next:       next,
+		maxRetries: maxRetries,
+		minWait:    retryMinWait,
+		maxWait:    maxWait,
+		log:        log,
+		sleep:      sleepContext,
+	}
+}
+
+func (rt *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.maxRetries <= 0 {
+		return rt.next.RoundTrip(req)
+	}
+
+	// the body has to be replayed on every attempt, the generated client
+	// streams it through a pipe so it is buffered here when it can't be rewound
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		r, err := rt.rewind(req, body)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := rt.next.RoundTrip(r)
+		if attempt >= rt.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := rt.backoff(attempt, resp)
+		if resp != nil {
+			rt.logf("%s %s: got %s, retrying in %v (%d/%d)", req.Method, req.URL.Path, resp.Status, wait, attempt+1, rt.maxRetries)
+			// drain the body so the connection can be reused
+			_, _ = ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		} else {
+			rt.logf("%s %s: %v, retrying in %v (%d/%d)", req.Method, req.URL.Path, err, wait, attempt+1, rt.maxRetries)
+		}
+
+		if err := rt.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (rt *retryRoundTripper) rewind(req *http.Request, body []byte) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	switch {
+	case body != nil:
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+	case req.GetBody != nil:
+		b, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = b
+	}
+	return r, nil
+}
+
+// backoff returns the time to wait before the next attempt. The exponential
+// backoff is jittered to spread retries of concurrent requests, a Retry-After
+// header sent by the server takes precedence. Both are capped by maxWait.
+func (rt *retryRoundTripper) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if wait > rt.maxWait {
+				return rt.maxWait
+			}
+			return wait
+		}
+	}
+
+	wait := rt.minWait << uint(attempt)
+	if wait <= 0 || wait > rt.maxWait {
+		wait = rt.maxWait
+	}
+	// keep at least half of the backoff and randomize the rest
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func (rt *retryRoundTripper) logf(template string, args ...interface{}) {
+	if rt.log != nil {
+		rt.log.Debugf(template, args...)
+	}
+}
+
+// shouldRetry reports whether the request can be sent again. Non-idempotent
+// requests, like the POSTs creating clusters and projects, may already have
+// been processed by the server, so they are only retried if the server
+// rejected them with 429 or 503, or the connection failed before sending them.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	idempotent := isIdempotent(req.Method)
+	if err != nil {
+		return isRetryableError(err) && (idempotent || isDialError(err))
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return idempotent
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableError reports whether the transport error is transient, that is
+// a reset or refused connection or a timeout. Other errors, like unknown hosts
+// or certificate errors, don't resolve by themselves.
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isDialError reports whether the connection failed before the request was sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// parseRetryAfter parses the Retry-After header, given either in seconds or as HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil {
+		if s < 0 {
+			return 0, false
+		}
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if wait := t.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,365 @@
+package kubermatic
+
+import (
+	"context"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	oclient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+func TestRetryRoundTripper(t *testing.T) {
+	cases := []struct {
+		Name           string
+		Method         string
+		Responses      []int
+		RetryAfter     string
+		ExpectStatus   int
+		ExpectAttempts int
+		ExpectWaits    []time.Duration
+	}{
+		{
+			Name:           "success",
+			Method:         http.MethodGet,
+			Responses:      []int{200},
+			ExpectStatus:   200,
+			ExpectAttempts: 1,
+		},
+		{
+			Name:           "retry on service unavailable",
+			Method:         http.MethodGet,
+			Responses:      []int{503, 502, 200},
+			ExpectStatus:   200,
+			ExpectAttempts: 3,
+		},
+		{
+			Name:           "retry post with body",
+			Method:         http.MethodPost,
+			Responses:      []int{503, 429, 201},
+			ExpectStatus:   201,
+			ExpectAttempts: 3,
+		},
+		{
+			Name:           "no retry of post on internal server error",
+			Method:         http.MethodPost,
+			Responses:      []int{500, 201},
+			ExpectStatus:   500,
+			ExpectAttempts: 1,
+		},
+		{
+			Name:           "no retry of patch on bad gateway",
+			Method:         http.MethodPatch,
+			Responses:      []int{502, 200},
+			ExpectStatus:   502,
+			ExpectAttempts: 1,
+		},
+		{
+			Name:           "retry delete on internal server error",
+			Method:         http.MethodDelete,
+			Responses:      []int{500, 200},
+			ExpectStatus:   200,
+			ExpectAttempts: 2,
+		},
+		{
+			Name:           "honour retry after",
+			Method:         http.MethodGet,
+			Responses:      []int{429, 200},
+			RetryAfter:     "2",
+			ExpectStatus:   200,
+			ExpectAttempts: 2,
+			ExpectWaits:    []time.Duration{2 * time.Second},
+		},
+		{
+			Name:           "retry after capped by max wait",
+			Method:         http.MethodGet,
+			Responses:      []int{429, 200},
+			RetryAfter:     "120",
+			ExpectStatus:   200,
+			ExpectAttempts: 2,
+			ExpectWaits:    []time.Duration{10 * time.Second},
+		},
+		{
+			Name:           "retries exhausted",
+			Method:         http.MethodGet,
+			Responses:      []int{503, 503, 503, 503},
+			ExpectStatus:   503,
+			ExpectAttempts: 4,
+		},
+		{
+			Name:           "no retry on client error",
+			Method:         http.MethodGet,
+			Responses:      []int{400, 200},
+			ExpectStatus:   400,
+			ExpectAttempts: 1,
+		},
+		{
+			Name:           "no retry on not implemented",
+			Method:         http.MethodGet,
+			Responses:      []int{501, 200},
+			ExpectStatus:   501,
+			ExpectAttempts: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+					b, _ := ioutil.ReadAll(r.Body)
+					if string(b) != "payload" {
+						t.Errorf("Unexpected body on attempt %d: %q", attempts+1, b)
+					}
+				}
+				if tc.RetryAfter != "" {
+					w.Header().Set("Retry-After", tc.RetryAfter)
+				}
+				w.WriteHeader(tc.Responses[attempts])
+				attempts++
+			}))
+			defer srv.Close()
+
+			var waits []time.Duration
+			rt := newRetryRoundTripper(http.DefaultTransport, 3, 10*time.Second, nil)
+			rt.sleep = func(_ context.Context, d time.Duration) error {
+				waits = append(waits, d)
+				return nil
+			}
+
+			// wrap the body so it can't be rewound, like the generated client does
+			req, err := http.NewRequest(tc.Method, srv.URL, ioutil.NopCloser(strings.NewReader("payload")))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.ExpectStatus {
+				t.Errorf("Want status %d, got %d", tc.ExpectStatus, resp.StatusCode)
+			}
+			if attempts != tc.ExpectAttempts {
+				t.Errorf("Want %d attempts, got %d", tc.ExpectAttempts, attempts)
+			}
+			if len(waits) != tc.ExpectAttempts-1 {
+				t.Errorf("Want %d waits, got %d", tc.ExpectAttempts-1, len(waits))
+			}
+			for i, w := range tc.ExpectWaits {
+				if waits[i] != w {
+					t.Errorf("Want wait %v, got %v", w, waits[i])
+				}
+			}
+		})
+	}
+}
+
+func TestClientRetryAfterAboveDefaultTimeout(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Timeout     time.Duration
+		ExpectError bool
+	}{
+		{
+			Name: "no request timeout",
+		},
+		{
+			Name:        "request timeout below retry after",
+			Timeout:     10 * time.Second,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if attempts == 1 {
+					w.Header().Set("Retry-After", "60")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			httpClient, err := newHTTPClient(transportConfig{timeout: tc.Timeout, maxRetries: 1, retryMaxWait: 2 * time.Minute})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// don't wait, but fail like the real sleep if the request deadline is too close
+			httpClient.Transport.(*retryRoundTripper).sleep = func(ctx context.Context, d time.Duration) error {
+				if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+					return context.DeadlineExceeded
+				}
+				return nil
+			}
+			client, err := newClient(srv.URL, httpClient)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// set the timeout like the generated params do
+			_, err = client.Transport.Submit(&runtime.ClientOperation{
+				ID:          "getThing",
+				Method:      http.MethodGet,
+				PathPattern: "/things",
+				Params: runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+					return r.SetTimeout(oclient.DefaultTimeout)
+				}),
+				Reader: runtime.ClientResponseReaderFunc(func(r runtime.ClientResponse, _ runtime.Consumer) (interface{}, error) {
+					if r.Code() != http.StatusOK {
+						return nil, runtime.NewAPIError("unexpected response", nil, r.Code())
+					}
+					return nil, nil
+				}),
+			})
+			if tc.ExpectError != (err != nil) {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !tc.ExpectError && attempts != 2 {
+				t.Errorf("Want 2 attempts, got %d", attempts)
+			}
+		})
+	}
+}
+
+func TestRetryRoundTripperConnectionError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := srv.URL
+	srv.Close()
+
+	attempts := 0
+	rt := newRetryRoundTripper(http.DefaultTransport, 2, time.Second, nil)
+	rt.sleep = func(_ context.Context, d time.Duration) error {
+		attempts++
+		return nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Fatal("Expected error, got none")
+	}
+	if attempts != 2 {
+		t.Errorf("Want 2 retries, got %d", attempts)
+	}
+}
+
+func TestShouldRetryError(t *testing.T) {
+	dial := func(err error) error {
+		return &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", err)}
+	}
+	read := func(err error) error {
+		return &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", err)}
+	}
+
+	cases := []struct {
+		Name     string
+		Method   string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name:     "connection refused",
+			Method:   http.MethodPost,
+			Err:      dial(syscall.ECONNREFUSED),
+			Expected: true,
+		},
+		{
+			Name:     "connection reset of get",
+			Method:   http.MethodGet,
+			Err:      read(syscall.ECONNRESET),
+			Expected: true,
+		},
+		{
+			Name:   "connection reset of post",
+			Method: http.MethodPost,
+			Err:    read(syscall.ECONNRESET),
+		},
+		{
+			Name:     "dial timeout of post",
+			Method:   http.MethodPost,
+			Err:      &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{IsTimeout: true}},
+			Expected: true,
+		},
+		{
+			Name:   "unknown host",
+			Method: http.MethodGet,
+			Err:    &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "kubermatic.invalid", IsNotFound: true}},
+		},
+		{
+			Name:   "certificate",
+			Method: http.MethodGet,
+			Err:    x509.UnknownAuthorityError{},
+		},
+		{
+			Name:   "context deadline",
+			Method: http.MethodGet,
+			Err:    context.DeadlineExceeded,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req, err := http.NewRequest(tc.Method, "https://kubermatic.invalid", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := shouldRetry(req, nil, tc.Err); got != tc.Expected {
+				t.Errorf("Want %v, got %v", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	rt := newRetryRoundTripper(nil, 10, 8*time.Second, nil)
+	for attempt := 0; attempt < 10; attempt++ {
+		want := rt.minWait << uint(attempt)
+		if want > rt.maxWait {
+			want = rt.maxWait
+		}
+		got := rt.backoff(attempt, nil)
+		if got < want/2 || got > want {
+			t.Errorf("attempt %d: want backoff between %v and %v, got %v", attempt, want/2, want, got)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		Value    string
+		Expected time.Duration
+		OK       bool
+	}{
+		{Value: "", OK: false},
+		{Value: "5", Expected: 5 * time.Second, OK: true},
+		{Value: "-1", OK: false},
+		{Value: "soon", OK: false},
+		{Value: now.Add(time.Minute).Format(http.TimeFormat), Expected: time.Minute, OK: true},
+		{Value: now.Add(-time.Minute).Format(http.TimeFormat), Expected: 0, OK: true},
+	}
+
+	for _, tc := range cases {
+		got, ok := parseRetryAfter(tc.Value, now)
+		if ok != tc.OK || got != tc.Expected {
+			t.Errorf("%q: want (%v, %v), got (%v, %v)", tc.Value, tc.Expected, tc.OK, got, ok)
+		}
+	}
+}
@@ -47,6 +47,60 @@ func clusterSpecFields() map[string]*schema.Schema {
 						},
 					},
 					"azure": azureCloudSpecSchema(),
+					"gcp": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "GCP cluster specification",
+						Elem: &schema.Resource{
+							Schema: gcpCloudSpecFields(),
+						},
+					},
+					"vsphere": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "VSphere cluster specification",
+						Elem: &schema.Resource{
+							Schema: vsphereCloudSpecFields(),
+						},
+					},
+					"hetzner": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "Hetzner cluster specification",
+						Elem: &schema.Resource{
+							Schema: hetznerCloudSpecFields(),
+						},
+					},
+					"digitalocean": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "DigitalOcean cluster specification",
+						Elem: &schema.Resource{
+							Schema: digitaloceanCloudSpecFields(),
+						},
+					},
+					"packet": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "Packet cluster specification",
+						Elem: &schema.Resource{
+							Schema: packetCloudSpecFields(),
+						},
+					},
+					"kubevirt": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "KubeVirt cluster specification",
+						Elem: &schema.Resource{
+							Schema: kubevirtCloudSpecFields(),
+						},
+					},
 				},
 			},
 		},
@@ -284,6 +338,175 @@ func openstackCloudSpecFields() map[string]*schema.Schema {
 	}
 }
 
+func gcpCloudSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"service_account": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Base64 encoded GCP service account",
+		},
+		"network": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Network name the nodes are connected to",
+		},
+		"subnetwork": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Subnetwork path the nodes are connected to",
+		},
+	}
+}
+
+func vsphereCloudSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"username": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Username for vSphere",
+		},
+		"password": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Password for vSphere",
+		},
+		"infra_management_user": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Credentials of the user used by the Kubermatic controllers for infrastructure management, if different from the cloud provider user",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"username": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.NoZeroValues,
+					},
+					"password": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+			},
+		},
+		"vm_net_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the vSphere network the VMs are connected to",
+		},
+		"folder": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Folder to be used to group the provisioned virtual machines",
+		},
+		"datastore": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"spec.0.cloud.0.vsphere.0.datastore_cluster"},
+			Description:   "Datastore to be used for storing virtual machines and as a default for dynamic volume provisioning",
+		},
+		"datastore_cluster": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"spec.0.cloud.0.vsphere.0.datastore"},
+			Description:   "Datastore cluster to be used for storing virtual machines, mutually exclusive with datastore",
+		},
+		"resource_pool": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Resource pool to be used for the virtual machines",
+		},
+	}
+}
+
+func hetznerCloudSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"token": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Hetzner API token",
+		},
+		"network": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Network name the nodes are connected to, if not set the datacenter default is used",
+		},
+	}
+}
+
+func digitaloceanCloudSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"token": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "DigitalOcean API token",
+		},
+	}
+}
+
+func packetCloudSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_key": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Packet API key",
+		},
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Packet project identifier",
+		},
+		"billing_cycle": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "hourly",
+			ValidateFunc: validation.StringInSlice([]string{"hourly", "daily"}, false),
+			Description:  "Billing cycle of the provisioned devices, either hourly or daily",
+		},
+	}
+}
+
+func kubevirtCloudSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"kubeconfig": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Kubeconfig of the KubeVirt infrastructure cluster",
+		},
+	}
+}
+
+func clusterHealthFields() map[string]*schema.Schema {
+	fields := make(map[string]*schema.Schema)
+	for _, c := range clusterHealthComponents {
+		fields[c.name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: c.description + " status, either up, down or provisioning",
+		}
+	}
+	return fields
+}
+
 func kubernetesConfigSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:      schema.TypeList,
@@ -293,8 +516,39 @@ func kubernetesConfigSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"raw_config": {
-					Type:     schema.TypeString,
-					Computed: true,
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Raw kubeconfig of the cluster",
+				},
+				"context_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the kubeconfig context used for the other attributes",
+				},
+				"host": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Address of the cluster API server",
+				},
+				"cluster_ca_certificate": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "PEM encoded root certificate of the cluster",
+				},
+				"client_certificate": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "PEM encoded client certificate used to authenticate against the cluster",
+				},
+				"client_key": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "PEM encoded client key used to authenticate against the cluster",
+				},
+				"token": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Bearer token used to authenticate against the cluster",
 				},
 			},
 		},
@@ -3,6 +3,8 @@ package kubermatic
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -21,6 +23,24 @@ func validateLabelOrTag(key string) error {
 	return nil
 }
 
+// suppressAutoscaledReplicasDiff ignores replicas changed by the cluster
+// autoscaler, as long as they are within the configured bounds.
+func suppressAutoscaledReplicasDiff(k, old, new string, d *schema.ResourceData) bool {
+	prefix := strings.TrimSuffix(k, "replicas")
+	return autoscaledReplicasWithinBounds(old, d.Get(prefix+"min_replicas").(int), d.Get(prefix+"max_replicas").(int))
+}
+
+func autoscaledReplicasWithinBounds(replicas string, min, max int) bool {
+	if max == 0 {
+		return false
+	}
+	r, err := strconv.Atoi(replicas)
+	if err != nil {
+		return false
+	}
+	return min <= r && r <= max
+}
+
 func nodeDeploymentSpecFields() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"dynamic_config": {
@@ -30,10 +50,31 @@ func nodeDeploymentSpecFields() map[string]*schema.Schema {
 			Description: "Use kubermatic dynamic config",
 		},
 		"replicas": {
-			Type:        schema.TypeInt,
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          1,
+			Description:      "Number of replicas, with autoscaling the initial number of replicas",
+			DiffSuppressFunc: suppressAutoscaledReplicasDiff,
+		},
+		"min_replicas": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Minimum number of replicas the cluster autoscaler scales down to, requires max_replicas",
+		},
+		"max_replicas": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Maximum number of replicas the cluster autoscaler scales up to, enables autoscaling",
+		},
+		// the API has no fields for the rolling update strategy, so max surge,
+		// max unavailable and min ready seconds can't be configured
+		"paused": {
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     1,
-			Description: "Number of replicas",
+			Default:     false,
+			Description: "Pause rollouts of template changes",
 		},
 		"template": {
 			Type:        schema.TypeList,
@@ -74,69 +115,73 @@ func nodeDeploymentSpecFields() map[string]*schema.Schema {
 									},
 								},
 								"azure": nodeDeploymentSpecCloudAzureSchema(),
-							},
-						},
-					},
-					"operating_system": {
-						Type:        schema.TypeList,
-						Required:    true,
-						MinItems:    1,
-						MaxItems:    1,
-						Description: "Operating system",
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								// TODO: add missing operating systems
-								"ubuntu": {
+								"gcp": {
 									Type:        schema.TypeList,
 									Optional:    true,
 									MaxItems:    1,
-									Description: "Ubuntu operating system",
+									Description: "GCP node deployment specification",
 									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"dist_upgrade_on_boot": {
-												Type:        schema.TypeBool,
-												Optional:    true,
-												Default:     false,
-												Description: "Upgrade operating system on boot",
-											},
-										},
+										Schema: gcpNodeFields(),
 									},
 								},
-								"flatcar": {
+								"vsphere": {
 									Type:        schema.TypeList,
 									Optional:    true,
 									MaxItems:    1,
-									Description: "Flatcar operating system",
+									Description: "VSphere node deployment specification",
 									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"disable_auto_update": {
-												Type:        schema.TypeBool,
-												Optional:    true,
-												Default:     false,
-												Description: "Disable auto update.",
-											},
-										},
+										Schema: vsphereNodeFields(),
 									},
 								},
-								"centos": {
+								"hetzner": {
 									Type:        schema.TypeList,
 									Optional:    true,
 									MaxItems:    1,
-									Description: "CentOS operating system",
+									Description: "Hetzner node deployment specification",
 									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"dist_upgrade_on_boot": {
-												Type:        schema.TypeBool,
-												Optional:    true,
-												Default:     false,
-												Description: "Upgrade operating system on boot",
-											},
-										},
+										Schema: hetznerNodeFields(),
+									},
+								},
+								"digitalocean": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "DigitalOcean node deployment specification",
+									Elem: &schema.Resource{
+										Schema: digitaloceanNodeFields(),
+									},
+								},
+								"packet": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "Packet node deployment specification",
+									Elem: &schema.Resource{
+										Schema: packetNodeFields(),
+									},
+								},
+								"kubevirt": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "KubeVirt node deployment specification",
+									Elem: &schema.Resource{
+										Schema: kubevirtNodeFields(),
 									},
 								},
 							},
 						},
 					},
+					"operating_system": {
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						MaxItems:    1,
+						Description: "Operating system",
+						Elem: &schema.Resource{
+							Schema: operatingSystemFields(),
+						},
+					},
 					"versions": {
 						Type:        schema.TypeList,
 						Optional:    true,
@@ -205,6 +250,114 @@ func nodeDeploymentSpecFields() map[string]*schema.Schema {
 	}
 }
 
+func operatingSystemFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ubuntu": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Ubuntu operating system",
+			Elem: &schema.Resource{
+				Schema: distUpgradeOnBootFields(),
+			},
+		},
+		"flatcar": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Flatcar operating system",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"disable_auto_update": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Disable auto update.",
+					},
+				},
+			},
+		},
+		"centos": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "CentOS operating system",
+			Elem: &schema.Resource{
+				Schema: distUpgradeOnBootFields(),
+			},
+		},
+		"rhel": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Red Hat Enterprise Linux operating system",
+			Elem: &schema.Resource{
+				Schema: rhelFields(),
+			},
+		},
+		"sles": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "SUSE Linux Enterprise Server operating system",
+			Elem: &schema.Resource{
+				Schema: distUpgradeOnBootFields(),
+			},
+		},
+		"amzn2": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Amazon Linux 2 operating system",
+			Elem: &schema.Resource{
+				Schema: distUpgradeOnBootFields(),
+			},
+		},
+		"rockylinux": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Rocky Linux operating system",
+			Elem: &schema.Resource{
+				Schema: distUpgradeOnBootFields(),
+			},
+		},
+	}
+}
+
+func distUpgradeOnBootFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"dist_upgrade_on_boot": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Upgrade operating system on boot",
+		},
+	}
+}
+
+func rhelFields() map[string]*schema.Schema {
+	fields := distUpgradeOnBootFields()
+	fields["subscription_manager_user"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "User of the Red Hat subscription manager",
+	}
+	fields["subscription_manager_password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Password of the Red Hat subscription manager",
+	}
+	fields["offline_token"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Red Hat subscription manager offline token, used to remove the subscription when the machine is deleted",
+	}
+	return fields
+}
+
 func awsNodeFields() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"instance_type": {
@@ -394,3 +547,198 @@ func nodeDeploymentSpecCloudAzureSchema() *schema.Schema {
 		},
 	}
 }
+
+func gcpNodeFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Availability zone in which to place the node",
+		},
+		"machine_type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "GCE machine type",
+		},
+		"disk_size": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Size of the disk in GBs",
+		},
+		"disk_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"pd-standard", "pd-balanced", "pd-ssd"}, false),
+			Description:  "Type of the disk, either pd-standard, pd-balanced or pd-ssd",
+		},
+		"preemptible": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Use preemptible instances",
+		},
+		"labels": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Computed:    true,
+			Description: "Additional instance labels",
+			Elem:        schema.TypeString,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return isLabelOrTagReserved(k)
+			},
+			ValidateFunc: func(v interface{}, k string) (strings []string, errors []error) {
+				l := v.(map[string]interface{})
+				for key := range l {
+					if err := validateLabelOrTag(key); err != nil {
+						errors = append(errors, err)
+					}
+				}
+				return
+			},
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Additional instance network tags",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func vsphereNodeFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cpus": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Number of CPUs",
+		},
+		"memory": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Memory in MB",
+		},
+		"disk_size_gb": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Disk size in GB. If not set, the disk size of the template is used",
+		},
+		"template": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the template VM to clone the nodes from",
+		},
+	}
+}
+
+func hetznerNodeFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Server type",
+		},
+		"network": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Network name the server is attached to, if not set the cluster network is used",
+		},
+	}
+}
+
+func digitaloceanNodeFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"size": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Droplet size slug",
+		},
+		"backups": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable backups for the droplet",
+		},
+		"ipv6": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable IPv6 for the droplet",
+		},
+		"monitoring": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable monitoring for the droplet",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Additional droplet tags",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func packetNodeFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Device plan",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Additional device tags",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func kubevirtNodeFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cpus": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Number of CPUs of the virtual machine, e.g. 2",
+		},
+		"memory": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Memory of the virtual machine as a Kubernetes quantity, e.g. 2048M",
+		},
+		"namespace": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Namespace in the KubeVirt cluster the virtual machines are created in",
+		},
+		"source_url": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "URL of the operating system image imported into the root disk PVC",
+		},
+		"storage_class_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Storage class of the root disk PVC",
+		},
+		"pvc_size": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Size of the root disk PVC as a Kubernetes quantity, e.g. 10Gi",
+		},
+	}
+}
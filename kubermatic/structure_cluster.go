@@ -1,7 +1,11 @@
 package kubermatic
 
 import (
+	"encoding/base64"
+	"fmt"
+
 	"github.com/kubermatic/go-kubermatic/models"
+	"gopkg.in/yaml.v2"
 )
 
 // flatteners
@@ -123,6 +127,30 @@ func flattenClusterCloudSpec(values clusterPreserveValues, in *models.CloudSpec)
 		att["azure"] = flattenAzureSpec(values.azure)
 	}
 
+	if in.Gcp != nil {
+		att["gcp"] = flattenGCPCloudSpec(values.gcp, in.Gcp)
+	}
+
+	if in.Vsphere != nil {
+		att["vsphere"] = flattenVSphereCloudSpec(values.vsphere, in.Vsphere)
+	}
+
+	if in.Hetzner != nil {
+		att["hetzner"] = flattenHetznerCloudSpec(values.hetzner, in.Hetzner)
+	}
+
+	if in.Digitalocean != nil {
+		att["digitalocean"] = flattenDigitaloceanCloudSpec(values.digitalocean, in.Digitalocean)
+	}
+
+	if in.Packet != nil {
+		att["packet"] = flattenPacketCloudSpec(values.packet, in.Packet)
+	}
+
+	if in.Kubevirt != nil {
+		att["kubevirt"] = flattenKubevirtCloudSpec(values.kubevirt, in.Kubevirt)
+	}
+
 	return []interface{}{att}
 }
 
@@ -256,6 +284,329 @@ func flattenAzureSpec(in *models.AzureCloudSpec) []interface{} {
 	return []interface{}{att}
 }
 
+func flattenGCPCloudSpec(values *models.GCPCloudSpec, in *models.GCPCloudSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.Network != "" {
+		att["network"] = in.Network
+	}
+
+	if in.Subnetwork != "" {
+		att["subnetwork"] = in.Subnetwork
+	}
+
+	// API does not return the service account, so we preserve the value used for creation
+	if values != nil && values.ServiceAccount != "" {
+		att["service_account"] = values.ServiceAccount
+	}
+
+	return []interface{}{att}
+}
+
+func flattenVSphereCloudSpec(values *models.VSphereCloudSpec, in *models.VSphereCloudSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.VMNetName != "" {
+		att["vm_net_name"] = in.VMNetName
+	}
+
+	if in.Folder != "" {
+		att["folder"] = in.Folder
+	}
+
+	if in.Datastore != "" {
+		att["datastore"] = in.Datastore
+	}
+
+	if in.DatastoreCluster != "" {
+		att["datastore_cluster"] = in.DatastoreCluster
+	}
+
+	if in.ResourcePool != "" {
+		att["resource_pool"] = in.ResourcePool
+	}
+
+	// API does not return credentials, so we preserve the values used for creation
+	if values != nil {
+		if values.Username != "" {
+			att["username"] = values.Username
+		}
+
+		if values.Password != "" {
+			att["password"] = values.Password
+		}
+
+		if values.InfraManagementUser != nil {
+			att["infra_management_user"] = []interface{}{
+				map[string]interface{}{
+					"username": values.InfraManagementUser.Username,
+					"password": values.InfraManagementUser.Password,
+				},
+			}
+		}
+	}
+
+	return []interface{}{att}
+}
+
+func flattenHetznerCloudSpec(values *models.HetznerCloudSpec, in *models.HetznerCloudSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.Network != "" {
+		att["network"] = in.Network
+	}
+
+	// API does not return the token, so we preserve the value used for creation
+	if values != nil && values.Token != "" {
+		att["token"] = values.Token
+	}
+
+	return []interface{}{att}
+}
+
+func flattenDigitaloceanCloudSpec(values *models.DigitaloceanCloudSpec, in *models.DigitaloceanCloudSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	// API does not return the token, so we preserve the value used for creation
+	if values != nil && values.Token != "" {
+		att["token"] = values.Token
+	}
+
+	return []interface{}{att}
+}
+
+func flattenPacketCloudSpec(values *models.PacketCloudSpec, in *models.PacketCloudSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.BillingCycle != "" {
+		att["billing_cycle"] = in.BillingCycle
+	}
+
+	// API does not return the credentials, so we preserve the values used for creation
+	if values != nil {
+		if values.APIKey != "" {
+			att["api_key"] = values.APIKey
+		}
+		if values.ProjectID != "" {
+			att["project_id"] = values.ProjectID
+		}
+	}
+
+	return []interface{}{att}
+}
+
+func flattenKubevirtCloudSpec(values *models.KubevirtCloudSpec, in *models.KubevirtCloudSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	// API does not return the kubeconfig, so we preserve the value used for creation
+	if values != nil && values.Kubeconfig != "" {
+		att["kubeconfig"] = values.Kubeconfig
+	}
+
+	return []interface{}{att}
+}
+
+// kubeConfig is the subset of a kubeconfig file needed to expose
+// connection details of a cluster.
+type kubeConfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+func flattenKubeConfig(raw []byte) ([]interface{}, error) {
+	if len(raw) == 0 {
+		return []interface{}{}, nil
+	}
+
+	var c kubeConfig
+	if err := yaml.Unmarshal(raw, &c); err != nil {
+		return nil, err
+	}
+
+	// use cluster and user of the current context, fall back to the first ones
+	var contextName, clusterName, userName string
+	for i, ctx := range c.Contexts {
+		if ctx.Name == c.CurrentContext || (c.CurrentContext == "" && i == 0) {
+			contextName = ctx.Name
+			clusterName = ctx.Context.Cluster
+			userName = ctx.Context.User
+			break
+		}
+	}
+
+	att := map[string]interface{}{
+		"raw_config":   string(raw),
+		"context_name": contextName,
+	}
+
+	for i, cluster := range c.Clusters {
+		if cluster.Name != clusterName && (clusterName != "" || i > 0) {
+			continue
+		}
+		att["host"] = cluster.Cluster.Server
+		ca, err := base64.StdEncoding.DecodeString(cluster.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate authority data: %v", err)
+		}
+		att["cluster_ca_certificate"] = string(ca)
+		break
+	}
+
+	for i, user := range c.Users {
+		if user.Name != userName && (userName != "" || i > 0) {
+			continue
+		}
+		cert, err := base64.StdEncoding.DecodeString(user.User.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate data: %v", err)
+		}
+		key, err := base64.StdEncoding.DecodeString(user.User.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client key data: %v", err)
+		}
+		att["client_certificate"] = string(cert)
+		att["client_key"] = string(key)
+		att["token"] = user.User.Token
+		break
+	}
+
+	return []interface{}{att}, nil
+}
+
+// clusterHealthComponent is a control plane component reported by the cluster health.
+type clusterHealthComponent struct {
+	name        string
+	description string
+	status      func(*models.ClusterHealth) models.HealthStatus
+}
+
+var clusterHealthComponents = []clusterHealthComponent{
+	{"apiserver", "API server", func(h *models.ClusterHealth) models.HealthStatus { return h.Apiserver }},
+	{"cloud_provider_infrastructure", "Cloud provider infrastructure", func(h *models.ClusterHealth) models.HealthStatus { return h.CloudProviderInfrastructure }},
+	{"controller", "Kubermatic controller", func(h *models.ClusterHealth) models.HealthStatus { return h.Controller }},
+	{"etcd", "Etcd", func(h *models.ClusterHealth) models.HealthStatus { return h.Etcd }},
+	{"machine_controller", "Machine controller", func(h *models.ClusterHealth) models.HealthStatus { return h.MachineController }},
+	{"scheduler", "Scheduler", func(h *models.ClusterHealth) models.HealthStatus { return h.Scheduler }},
+	{"user_cluster_controller_manager", "User cluster controller manager", func(h *models.ClusterHealth) models.HealthStatus { return h.UserClusterControllerManager }},
+	{"gatekeeper_audit", "Gatekeeper audit", func(h *models.ClusterHealth) models.HealthStatus { return h.GatekeeperAudit }},
+	{"gatekeeper_controller", "Gatekeeper controller", func(h *models.ClusterHealth) models.HealthStatus { return h.GatekeeperController }},
+	{"logging", "MLA logging", func(h *models.ClusterHealth) models.HealthStatus { return h.Logging }},
+	{"monitoring", "MLA monitoring", func(h *models.ClusterHealth) models.HealthStatus { return h.Monitoring }},
+}
+
+// defaultClusterReadyComponents have to be up for a cluster to be ready.
+var defaultClusterReadyComponents = []string{
+	"apiserver",
+	"cloud_provider_infrastructure",
+	"controller",
+	"etcd",
+	"machine_controller",
+	"scheduler",
+	"user_cluster_controller_manager",
+}
+
+func clusterHealthComponentNames() []string {
+	var names []string
+	for _, c := range clusterHealthComponents {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+func healthStatusString(s models.HealthStatus) string {
+	switch s {
+	case healthStatusUp:
+		return "up"
+	case healthStatusProvisioning:
+		return "provisioning"
+	default:
+		return "down"
+	}
+}
+
+func flattenClusterHealth(in *models.ClusterHealth) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+	for _, c := range clusterHealthComponents {
+		att[c.name] = healthStatusString(c.status(in))
+	}
+	return []interface{}{att}
+}
+
+// clusterComponentsNotReady returns the components, which are not up, with their status.
+func clusterComponentsNotReady(in *models.ClusterHealth, components []string) []string {
+	var notReady []string
+	for _, c := range clusterHealthComponents {
+		for _, name := range components {
+			if name != c.name {
+				continue
+			}
+			if in == nil {
+				notReady = append(notReady, c.name+": unknown")
+			} else if s := c.status(in); s != healthStatusUp {
+				notReady = append(notReady, c.name+": "+healthStatusString(s))
+			}
+		}
+	}
+	return notReady
+}
+
+// clusterStatus returns the readiness of the cluster reported in the status attribute.
+func clusterStatus(in *models.ClusterHealth, components []string) string {
+	if len(clusterComponentsNotReady(in, components)) > 0 {
+		return statusNotReady
+	}
+	return statusReady
+}
+
 // expanders
 
 func expandClusterSpec(p []interface{}, dcName string) *models.ClusterSpec {
@@ -408,6 +759,30 @@ func expandClusterCloudSpec(p []interface{}, dcName string) *models.CloudSpec {
 		obj.Azure = expandAzureCloudSpec(v.([]interface{}))
 	}
 
+	if v, ok := in["gcp"]; ok {
+		obj.Gcp = expandGCPCloudSpec(v.([]interface{}))
+	}
+
+	if v, ok := in["vsphere"]; ok {
+		obj.Vsphere = expandVSphereCloudSpec(v.([]interface{}))
+	}
+
+	if v, ok := in["hetzner"]; ok {
+		obj.Hetzner = expandHetznerCloudSpec(v.([]interface{}))
+	}
+
+	if v, ok := in["digitalocean"]; ok {
+		obj.Digitalocean = expandDigitaloceanCloudSpec(v.([]interface{}))
+	}
+
+	if v, ok := in["packet"]; ok {
+		obj.Packet = expandPacketCloudSpec(v.([]interface{}))
+	}
+
+	if v, ok := in["kubevirt"]; ok {
+		obj.Kubevirt = expandKubevirtCloudSpec(v.([]interface{}))
+	}
+
 	return obj
 }
 
@@ -564,3 +939,177 @@ func expandAzureCloudSpec(p []interface{}) *models.AzureCloudSpec {
 
 	return obj
 }
+
+func expandGCPCloudSpec(p []interface{}) *models.GCPCloudSpec {
+	if len(p) < 1 {
+		return nil
+	}
+
+	obj := &models.GCPCloudSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["service_account"]; ok {
+		obj.ServiceAccount = v.(string)
+	}
+
+	if v, ok := in["network"]; ok {
+		obj.Network = v.(string)
+	}
+
+	if v, ok := in["subnetwork"]; ok {
+		obj.Subnetwork = v.(string)
+	}
+
+	return obj
+}
+
+func expandVSphereCloudSpec(p []interface{}) *models.VSphereCloudSpec {
+	if len(p) < 1 {
+		return nil
+	}
+
+	obj := &models.VSphereCloudSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["username"]; ok {
+		obj.Username = v.(string)
+	}
+
+	if v, ok := in["password"]; ok {
+		obj.Password = v.(string)
+	}
+
+	if v, ok := in["infra_management_user"]; ok {
+		obj.InfraManagementUser = expandVSphereCredentials(v.([]interface{}))
+	}
+
+	if v, ok := in["vm_net_name"]; ok {
+		obj.VMNetName = v.(string)
+	}
+
+	if v, ok := in["folder"]; ok {
+		obj.Folder = v.(string)
+	}
+
+	if v, ok := in["datastore"]; ok {
+		obj.Datastore = v.(string)
+	}
+
+	if v, ok := in["datastore_cluster"]; ok {
+		obj.DatastoreCluster = v.(string)
+	}
+
+	if v, ok := in["resource_pool"]; ok {
+		obj.ResourcePool = v.(string)
+	}
+
+	return obj
+}
+
+func expandHetznerCloudSpec(p []interface{}) *models.HetznerCloudSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.HetznerCloudSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["token"]; ok {
+		obj.Token = v.(string)
+	}
+
+	if v, ok := in["network"]; ok {
+		obj.Network = v.(string)
+	}
+
+	return obj
+}
+
+func expandDigitaloceanCloudSpec(p []interface{}) *models.DigitaloceanCloudSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.DigitaloceanCloudSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["token"]; ok {
+		obj.Token = v.(string)
+	}
+
+	return obj
+}
+
+func expandPacketCloudSpec(p []interface{}) *models.PacketCloudSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.PacketCloudSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["api_key"]; ok {
+		obj.APIKey = v.(string)
+	}
+
+	if v, ok := in["project_id"]; ok {
+		obj.ProjectID = v.(string)
+	}
+
+	if v, ok := in["billing_cycle"]; ok {
+		obj.BillingCycle = v.(string)
+	}
+
+	return obj
+}
+
+func expandKubevirtCloudSpec(p []interface{}) *models.KubevirtCloudSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.KubevirtCloudSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["kubeconfig"]; ok {
+		obj.Kubeconfig = v.(string)
+	}
+
+	return obj
+}
+
+func expandVSphereCredentials(p []interface{}) *models.VSphereCredentials {
+	if len(p) < 1 {
+		return nil
+	}
+
+	obj := &models.VSphereCredentials{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["username"]; ok {
+		obj.Username = v.(string)
+	}
+
+	if v, ok := in["password"]; ok {
+		obj.Password = v.(string)
+	}
+
+	return obj
+}
@@ -289,6 +289,295 @@ func TestFlattenAzureCloudSpec(t *testing.T) {
 	}
 }
 
+func TestFlattenGCPCloudSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.GCPCloudSpec
+		PreserveValues *models.GCPCloudSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.GCPCloudSpec{
+				Network:    "global/networks/default",
+				Subnetwork: "projects/foo/regions/europe-west3/subnetworks/default",
+			},
+			&models.GCPCloudSpec{
+				ServiceAccount: "ServiceAccount",
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"service_account": "ServiceAccount",
+					"network":         "global/networks/default",
+					"subnetwork":      "projects/foo/regions/europe-west3/subnetworks/default",
+				},
+			},
+		},
+		{
+			&models.GCPCloudSpec{},
+			nil,
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenGCPCloudSpec(tc.PreserveValues, tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenVSphereCloudSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.VSphereCloudSpec
+		PreserveValues *models.VSphereCloudSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.VSphereCloudSpec{
+				VMNetName:    "VMNetName",
+				Folder:       "/dc-1/vm/kubernetes",
+				Datastore:    "Datastore",
+				ResourcePool: "ResourcePool",
+			},
+			&models.VSphereCloudSpec{
+				Username: "Username",
+				Password: "Password",
+				InfraManagementUser: &models.VSphereCredentials{
+					Username: "InfraUsername",
+					Password: "InfraPassword",
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"username": "Username",
+					"password": "Password",
+					"infra_management_user": []interface{}{
+						map[string]interface{}{
+							"username": "InfraUsername",
+							"password": "InfraPassword",
+						},
+					},
+					"vm_net_name":   "VMNetName",
+					"folder":        "/dc-1/vm/kubernetes",
+					"datastore":     "Datastore",
+					"resource_pool": "ResourcePool",
+				},
+			},
+		},
+		{
+			&models.VSphereCloudSpec{},
+			nil,
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenVSphereCloudSpec(tc.PreserveValues, tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenHetznerCloudSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.HetznerCloudSpec
+		PreserveValues *models.HetznerCloudSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.HetznerCloudSpec{
+				Network: "network",
+			},
+			&models.HetznerCloudSpec{
+				Token: "token",
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"token":   "token",
+					"network": "network",
+				},
+			},
+		},
+		{
+			&models.HetznerCloudSpec{},
+			nil,
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenHetznerCloudSpec(tc.PreserveValues, tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenPacketCloudSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.PacketCloudSpec
+		PreserveValues *models.PacketCloudSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.PacketCloudSpec{
+				BillingCycle: "hourly",
+			},
+			&models.PacketCloudSpec{
+				APIKey:    "api-key",
+				ProjectID: "project-id",
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"api_key":       "api-key",
+					"project_id":    "project-id",
+					"billing_cycle": "hourly",
+				},
+			},
+		},
+		{
+			&models.PacketCloudSpec{},
+			nil,
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenPacketCloudSpec(tc.PreserveValues, tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenKubevirtCloudSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.KubevirtCloudSpec
+		PreserveValues *models.KubevirtCloudSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.KubevirtCloudSpec{},
+			&models.KubevirtCloudSpec{
+				Kubeconfig: "kubeconfig",
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"kubeconfig": "kubeconfig",
+				},
+			},
+		},
+		{
+			&models.KubevirtCloudSpec{},
+			nil,
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenKubevirtCloudSpec(tc.PreserveValues, tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenKubeConfig(t *testing.T) {
+	raw := `apiVersion: v1
+kind: Config
+current-context: default
+clusters:
+- name: other
+  cluster:
+    server: https://other:6443
+    certificate-authority-data: b3RoZXI=
+- name: cluster
+  cluster:
+    server: https://cluster:6443
+    certificate-authority-data: Y2E=
+contexts:
+- name: default
+  context:
+    cluster: cluster
+    user: admin
+users:
+- name: admin
+  user:
+    client-certificate-data: Y2VydA==
+    client-key-data: a2V5
+    token: token
+`
+	cases := []struct {
+		Input          []byte
+		ExpectedOutput []interface{}
+	}{
+		{
+			[]byte(raw),
+			[]interface{}{
+				map[string]interface{}{
+					"raw_config":             raw,
+					"context_name":           "default",
+					"host":                   "https://cluster:6443",
+					"cluster_ca_certificate": "ca",
+					"client_certificate":     "cert",
+					"client_key":             "key",
+					"token":                  "token",
+				},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := flattenKubeConfig(tc.Input)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
 func TestFlattenOPAIntegration(t *testing.T) {
 	cases := []struct {
 		Input          *models.OPAIntegrationSettings
@@ -700,6 +989,211 @@ func TestExpandAzureCloudSpec(t *testing.T) {
 	}
 }
 
+func TestExpandGCPCloudSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.GCPCloudSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"service_account": "ServiceAccount",
+					"network":         "global/networks/default",
+					"subnetwork":      "projects/foo/regions/europe-west3/subnetworks/default",
+				},
+			},
+			&models.GCPCloudSpec{
+				ServiceAccount: "ServiceAccount",
+				Network:        "global/networks/default",
+				Subnetwork:     "projects/foo/regions/europe-west3/subnetworks/default",
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.GCPCloudSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandGCPCloudSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandVSphereCloudSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.VSphereCloudSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"username": "Username",
+					"password": "Password",
+					"infra_management_user": []interface{}{
+						map[string]interface{}{
+							"username": "InfraUsername",
+							"password": "InfraPassword",
+						},
+					},
+					"vm_net_name":       "VMNetName",
+					"folder":            "/dc-1/vm/kubernetes",
+					"datastore_cluster": "DatastoreCluster",
+					"resource_pool":     "ResourcePool",
+				},
+			},
+			&models.VSphereCloudSpec{
+				Username: "Username",
+				Password: "Password",
+				InfraManagementUser: &models.VSphereCredentials{
+					Username: "InfraUsername",
+					Password: "InfraPassword",
+				},
+				VMNetName:        "VMNetName",
+				Folder:           "/dc-1/vm/kubernetes",
+				DatastoreCluster: "DatastoreCluster",
+				ResourcePool:     "ResourcePool",
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.VSphereCloudSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandVSphereCloudSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandHetznerCloudSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.HetznerCloudSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"token":   "token",
+					"network": "network",
+				},
+			},
+			&models.HetznerCloudSpec{
+				Token:   "token",
+				Network: "network",
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.HetznerCloudSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandHetznerCloudSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandDigitaloceanCloudSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.DigitaloceanCloudSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"token": "token",
+				},
+			},
+			&models.DigitaloceanCloudSpec{
+				Token: "token",
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.DigitaloceanCloudSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandDigitaloceanCloudSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandPacketCloudSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.PacketCloudSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"api_key":       "api-key",
+					"project_id":    "project-id",
+					"billing_cycle": "daily",
+				},
+			},
+			&models.PacketCloudSpec{
+				APIKey:       "api-key",
+				ProjectID:    "project-id",
+				BillingCycle: "daily",
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.PacketCloudSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandPacketCloudSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
 func TestExpandOPAIntegration(t *testing.T) {
 	cases := []struct {
 		Input          []interface{}
@@ -810,3 +1304,102 @@ func TestExpandAuditLogging(t *testing.T) {
 		t.Fatalf("want %+v, got %+v", want, got)
 	}
 }
+
+func TestFlattenClusterHealth(t *testing.T) {
+	cases := []struct {
+		Input          *models.ClusterHealth
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.ClusterHealth{
+				Apiserver:                    healthStatusUp,
+				CloudProviderInfrastructure:  healthStatusUp,
+				Controller:                   healthStatusUp,
+				Etcd:                         healthStatusProvisioning,
+				MachineController:            healthStatusUp,
+				Scheduler:                    healthStatusUp,
+				UserClusterControllerManager: healthStatusUp,
+				Logging:                      healthStatusDown,
+				Monitoring:                   healthStatusUp,
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"apiserver":                       "up",
+					"cloud_provider_infrastructure":   "up",
+					"controller":                      "up",
+					"etcd":                            "provisioning",
+					"machine_controller":              "up",
+					"scheduler":                       "up",
+					"user_cluster_controller_manager": "up",
+					"gatekeeper_audit":                "down",
+					"gatekeeper_controller":           "down",
+					"logging":                         "down",
+					"monitoring":                      "up",
+				},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenClusterHealth(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestClusterComponentsNotReady(t *testing.T) {
+	health := &models.ClusterHealth{
+		Apiserver:                    healthStatusUp,
+		CloudProviderInfrastructure:  healthStatusUp,
+		Controller:                   healthStatusUp,
+		Etcd:                         healthStatusUp,
+		MachineController:            healthStatusProvisioning,
+		Scheduler:                    healthStatusUp,
+		UserClusterControllerManager: healthStatusUp,
+		Logging:                      healthStatusDown,
+	}
+
+	cases := []struct {
+		Name       string
+		Health     *models.ClusterHealth
+		Components []string
+		Expected   []string
+	}{
+		{
+			Name:       "default components",
+			Health:     health,
+			Components: defaultClusterReadyComponents,
+			Expected:   []string{"machine_controller: provisioning"},
+		},
+		{
+			Name:       "degraded logging ignored",
+			Health:     health,
+			Components: []string{"apiserver", "etcd"},
+		},
+		{
+			Name:       "logging required",
+			Health:     health,
+			Components: []string{"logging", "apiserver"},
+			Expected:   []string{"logging: down"},
+		},
+		{
+			Name:       "unknown health",
+			Components: []string{"apiserver"},
+			Expected:   []string{"apiserver: unknown"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got := clusterComponentsNotReady(tc.Health, tc.Components)
+			if diff := cmp.Diff(tc.Expected, got); diff != "" {
+				t.Fatalf("Unexpected components: mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package kubermatic
+
+import (
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+func flattenDatacenter(in *models.Datacenter) map[string]interface{} {
+	att := make(map[string]interface{})
+	if in == nil {
+		return att
+	}
+
+	if in.Metadata != nil {
+		att["name"] = in.Metadata.Name
+	}
+
+	if in.Spec == nil {
+		return att
+	}
+
+	att["seed"] = in.Spec.Seed
+	att["cloud_provider"] = in.Spec.Provider
+	att["country"] = in.Spec.Country
+	att["location"] = in.Spec.Location
+
+	if in.Spec.Aws != nil {
+		att["aws"] = []interface{}{
+			map[string]interface{}{
+				"region": in.Spec.Aws.Region,
+				"images": flattenImageList(in.Spec.Aws.Images),
+			},
+		}
+	}
+
+	if in.Spec.Openstack != nil {
+		att["openstack"] = []interface{}{
+			map[string]interface{}{
+				"auth_url":          in.Spec.Openstack.AuthURL,
+				"region":            in.Spec.Openstack.Region,
+				"availability_zone": in.Spec.Openstack.AvailabilityZone,
+				"images":            flattenImageList(in.Spec.Openstack.Images),
+			},
+		}
+	}
+
+	if in.Spec.Azure != nil {
+		att["azure"] = []interface{}{
+			map[string]interface{}{
+				"location": in.Spec.Azure.Location,
+			},
+		}
+	}
+
+	if in.Spec.Gcp != nil {
+		att["gcp"] = []interface{}{
+			map[string]interface{}{
+				"region": in.Spec.Gcp.Region,
+			},
+		}
+	}
+
+	return att
+}
+
+func flattenImageList(in models.ImageList) map[string]interface{} {
+	att := make(map[string]interface{}, len(in))
+	for os, image := range in {
+		att[os] = image
+	}
+	return att
+}
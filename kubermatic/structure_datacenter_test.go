@@ -0,0 +1,92 @@
+package kubermatic
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+func TestFlattenDatacenter(t *testing.T) {
+	cases := []struct {
+		Input          *models.Datacenter
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			&models.Datacenter{
+				Metadata: &models.DatacenterMeta{
+					Name: "os-hamburg",
+				},
+				Spec: &models.DatacenterSpec{
+					Seed:     "europe-west",
+					Provider: "openstack",
+					Country:  "DE",
+					Location: "Hamburg",
+					Openstack: &models.OpenstackDatacenterSpec{
+						AuthURL:          "https://keystone:5000/v3",
+						Region:           "hamburg",
+						AvailabilityZone: "hamburg-1",
+						Images: models.ImageList{
+							"ubuntu": "Ubuntu Focal",
+						},
+					},
+				},
+			},
+			map[string]interface{}{
+				"name":           "os-hamburg",
+				"seed":           "europe-west",
+				"cloud_provider": "openstack",
+				"country":        "DE",
+				"location":       "Hamburg",
+				"openstack": []interface{}{
+					map[string]interface{}{
+						"auth_url":          "https://keystone:5000/v3",
+						"region":            "hamburg",
+						"availability_zone": "hamburg-1",
+						"images": map[string]interface{}{
+							"ubuntu": "Ubuntu Focal",
+						},
+					},
+				},
+			},
+		},
+		{
+			&models.Datacenter{
+				Metadata: &models.DatacenterMeta{
+					Name: "aws-frankfurt",
+				},
+				Spec: &models.DatacenterSpec{
+					Seed:     "europe-west",
+					Provider: "aws",
+					Aws: &models.AWSDatacenterSpec{
+						Region: "eu-central-1",
+					},
+				},
+			},
+			map[string]interface{}{
+				"name":           "aws-frankfurt",
+				"seed":           "europe-west",
+				"cloud_provider": "aws",
+				"country":        "",
+				"location":       "",
+				"aws": []interface{}{
+					map[string]interface{}{
+						"region": "eu-central-1",
+						"images": map[string]interface{}{},
+					},
+				},
+			},
+		},
+		{
+			nil,
+			map[string]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenDatacenter(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
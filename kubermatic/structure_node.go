@@ -0,0 +1,117 @@
+package kubermatic
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+const (
+	nodeAddressInternalIP = "InternalIP"
+	nodeAddressExternalIP = "ExternalIP"
+)
+
+// flattenNodes flattens nodes sorted by name.
+func flattenNodes(in []*models.Node) []interface{} {
+	nodes := make([]*models.Node, 0, len(in))
+	for _, n := range in {
+		if n != nil {
+			nodes = append(nodes, n)
+		}
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	out := make([]interface{}, 0, len(nodes))
+	for _, n := range nodes {
+		out = append(out, flattenNode(n))
+	}
+	return out
+}
+
+func flattenNode(in *models.Node) map[string]interface{} {
+	att := map[string]interface{}{
+		"id":                 in.ID,
+		"name":               in.Name,
+		"machine_name":       machineName(in),
+		"creation_timestamp": in.CreationTimestamp.String(),
+		"deletion_timestamp": in.DeletionTimestamp.String(),
+		"addresses":          []interface{}{},
+		"internal_ips":       []interface{}{},
+		"external_ips":       []interface{}{},
+		"ready":              false,
+	}
+
+	if in.Spec != nil {
+		att["os_distribution"] = operatingSystemName(in.Spec.OperatingSystem)
+	}
+
+	if in.Status == nil {
+		return att
+	}
+
+	var addresses, internal, external []interface{}
+	for _, a := range in.Status.Addresses {
+		if a == nil {
+			continue
+		}
+		addresses = append(addresses, map[string]interface{}{
+			"type":    a.Type,
+			"address": a.Address,
+		})
+		switch a.Type {
+		case nodeAddressInternalIP:
+			internal = append(internal, a.Address)
+		case nodeAddressExternalIP:
+			external = append(external, a.Address)
+		}
+	}
+	if len(addresses) > 0 {
+		att["addresses"] = addresses
+	}
+	if len(internal) > 0 {
+		att["internal_ips"] = internal
+	}
+	if len(external) > 0 {
+		att["external_ips"] = external
+	}
+
+	att["error_reason"] = in.Status.ErrorReason
+	att["error_message"] = in.Status.ErrorMessage
+
+	if info := in.Status.NodeInfo; info != nil {
+		att["kubelet_version"] = info.KubeletVersion
+		att["operating_system"] = info.OperatingSystem
+		att["kernel_version"] = info.KernelVersion
+		att["architecture"] = info.Architecture
+		att["container_runtime"] = strings.TrimSpace(info.ContainerRuntime + " " + info.ContainerRuntimeVersion)
+		att["ready"] = info.KubeletVersion != "" && in.Status.ErrorReason == ""
+	}
+
+	return att
+}
+
+// operatingSystemName returns the name of the operating system selected in the spec.
+func operatingSystemName(in *models.OperatingSystemSpec) string {
+	switch {
+	case in == nil:
+		return ""
+	case in.Ubuntu != nil:
+		return "ubuntu"
+	case in.Centos != nil:
+		return "centos"
+	case in.Flatcar != nil:
+		return "flatcar"
+	case in.Rhel != nil:
+		return "rhel"
+	case in.Sles != nil:
+		return "sles"
+	case in.Amzn2 != nil:
+		return "amzn2"
+	case in.Rockylinux != nil:
+		return "rockylinux"
+	}
+	return ""
+}
@@ -16,12 +16,22 @@ func flattenNodeDeploymentSpec(values *nodeSpecPreservedValues, in *models.NodeD
 		att["replicas"] = *in.Replicas
 	}
 
+	if in.MinReplicas != nil {
+		att["min_replicas"] = *in.MinReplicas
+	}
+
+	if in.MaxReplicas != nil {
+		att["max_replicas"] = *in.MaxReplicas
+	}
+
 	if in.Template != nil {
 		att["template"] = flattenNodeSpec(values, in.Template)
 	}
 
 	att["dynamic_config"] = in.DynamicConfig
 
+	att["paused"] = in.Paused
+
 	return []interface{}{att}
 }
 
@@ -82,6 +92,22 @@ func flattenOperatingSystem(in *models.OperatingSystemSpec) []interface{} {
 		att["flatcar"] = flattenFlatcar(in.Flatcar)
 	}
 
+	if in.Rhel != nil {
+		att["rhel"] = flattenRhel(in.Rhel)
+	}
+
+	if in.Sles != nil {
+		att["sles"] = flattenSles(in.Sles)
+	}
+
+	if in.Amzn2 != nil {
+		att["amzn2"] = flattenAmzn2(in.Amzn2)
+	}
+
+	if in.Rockylinux != nil {
+		att["rockylinux"] = flattenRockyLinux(in.Rockylinux)
+	}
+
 	return []interface{}{att}
 }
 
@@ -121,6 +147,66 @@ func flattenFlatcar(in *models.FlatcarSpec) []interface{} {
 	return []interface{}{att}
 }
 
+func flattenRhel(in *models.RHELSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	att["dist_upgrade_on_boot"] = in.DistUpgradeOnBoot
+
+	if in.RHELSubscriptionManagerUser != "" {
+		att["subscription_manager_user"] = in.RHELSubscriptionManagerUser
+	}
+
+	if in.RHELSubscriptionManagerPassword != "" {
+		att["subscription_manager_password"] = in.RHELSubscriptionManagerPassword
+	}
+
+	if in.RHSMOfflineToken != "" {
+		att["offline_token"] = in.RHSMOfflineToken
+	}
+
+	return []interface{}{att}
+}
+
+func flattenSles(in *models.SLESSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	att["dist_upgrade_on_boot"] = in.DistUpgradeOnBoot
+
+	return []interface{}{att}
+}
+
+func flattenAmzn2(in *models.AmazonLinuxSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	att["dist_upgrade_on_boot"] = in.DistUpgradeOnBoot
+
+	return []interface{}{att}
+}
+
+func flattenRockyLinux(in *models.RockyLinuxSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	att["dist_upgrade_on_boot"] = in.DistUpgradeOnBoot
+
+	return []interface{}{att}
+}
+
 func flattenNodeVersion(in *models.NodeVersionInfo) []interface{} {
 	if in == nil {
 		return []interface{}{}
@@ -178,6 +264,30 @@ func flattenNodeCloudSpec(values *nodeSpecPreservedValues, in *models.NodeCloudS
 		att["azure"] = flattendAzureNodeSpec(values.azure)
 	}
 
+	if in.Gcp != nil {
+		att["gcp"] = flattenGCPNodeSpec(in.Gcp)
+	}
+
+	if in.Vsphere != nil {
+		att["vsphere"] = flattenVSphereNodeSpec(in.Vsphere)
+	}
+
+	if in.Hetzner != nil {
+		att["hetzner"] = flattenHetznerNodeSpec(in.Hetzner)
+	}
+
+	if in.Digitalocean != nil {
+		att["digitalocean"] = flattenDigitaloceanNodeSpec(in.Digitalocean)
+	}
+
+	if in.Packet != nil {
+		att["packet"] = flattenPacketNodeSpec(in.Packet)
+	}
+
+	if in.Kubevirt != nil {
+		att["kubevirt"] = flattenKubevirtNodeSpec(in.Kubevirt)
+	}
+
 	// TODO: add all cloud providers
 
 	return []interface{}{att}
@@ -299,6 +409,160 @@ func flattendAzureNodeSpec(in *models.AzureNodeSpec) []interface{} {
 	return []interface{}{att}
 }
 
+func flattenGCPNodeSpec(in *models.GCPNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.Zone != "" {
+		att["zone"] = in.Zone
+	}
+
+	if in.MachineType != "" {
+		att["machine_type"] = in.MachineType
+	}
+
+	if in.DiskSize != 0 {
+		att["disk_size"] = in.DiskSize
+	}
+
+	if in.DiskType != "" {
+		att["disk_type"] = in.DiskType
+	}
+
+	att["preemptible"] = in.Preemptible
+
+	if in.Labels != nil {
+		att["labels"] = in.Labels
+	}
+
+	if in.Tags != nil {
+		att["tags"] = in.Tags
+	}
+
+	return []interface{}{att}
+}
+
+func flattenVSphereNodeSpec(in *models.VSphereNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.CPUs != 0 {
+		att["cpus"] = in.CPUs
+	}
+
+	if in.Memory != 0 {
+		att["memory"] = in.Memory
+	}
+
+	if in.DiskSizeGB != 0 {
+		att["disk_size_gb"] = in.DiskSizeGB
+	}
+
+	if in.Template != "" {
+		att["template"] = in.Template
+	}
+
+	return []interface{}{att}
+}
+
+func flattenHetznerNodeSpec(in *models.HetznerNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.Type != nil {
+		att["type"] = *in.Type
+	}
+
+	if in.Network != "" {
+		att["network"] = in.Network
+	}
+
+	return []interface{}{att}
+}
+
+func flattenDigitaloceanNodeSpec(in *models.DigitaloceanNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.Size != nil {
+		att["size"] = *in.Size
+	}
+
+	att["backups"] = in.Backups
+	att["ipv6"] = in.IPV6
+	att["monitoring"] = in.Monitoring
+
+	if in.Tags != nil {
+		att["tags"] = in.Tags
+	}
+
+	return []interface{}{att}
+}
+
+func flattenPacketNodeSpec(in *models.PacketNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.InstanceType != nil {
+		att["instance_type"] = *in.InstanceType
+	}
+
+	if in.Tags != nil {
+		att["tags"] = in.Tags
+	}
+
+	return []interface{}{att}
+}
+
+func flattenKubevirtNodeSpec(in *models.KubevirtNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.CPUs != nil {
+		att["cpus"] = *in.CPUs
+	}
+
+	if in.Memory != nil {
+		att["memory"] = *in.Memory
+	}
+
+	if in.Namespace != nil {
+		att["namespace"] = *in.Namespace
+	}
+
+	if in.SourceURL != nil {
+		att["source_url"] = *in.SourceURL
+	}
+
+	if in.StorageClassName != nil {
+		att["storage_class_name"] = *in.StorageClassName
+	}
+
+	if in.PVCSize != nil {
+		att["pvc_size"] = *in.PVCSize
+	}
+
+	return []interface{}{att}
+}
+
 // expanders
 
 func expandNodeDeploymentSpec(p []interface{}) *models.NodeDeploymentSpec {
@@ -315,6 +579,15 @@ func expandNodeDeploymentSpec(p []interface{}) *models.NodeDeploymentSpec {
 		obj.Replicas = int32ToPtr(int32(v.(int)))
 	}
 
+	// autoscaling is enabled by max_replicas, since min_replicas may be 0
+	if v, ok := in["max_replicas"]; ok && v.(int) > 0 {
+		obj.MaxReplicas = int32ToPtr(int32(v.(int)))
+		obj.MinReplicas = int32ToPtr(0)
+		if v, ok := in["min_replicas"]; ok {
+			obj.MinReplicas = int32ToPtr(int32(v.(int)))
+		}
+	}
+
 	if v, ok := in["template"]; ok {
 		obj.Template = expandNodeSpec(v.([]interface{}))
 	}
@@ -323,6 +596,10 @@ func expandNodeDeploymentSpec(p []interface{}) *models.NodeDeploymentSpec {
 		obj.DynamicConfig = v.(bool)
 	}
 
+	if v, ok := in["paused"]; ok {
+		obj.Paused = v.(bool)
+	}
+
 	return obj
 }
 
@@ -388,6 +665,22 @@ func expandOperatingSystem(p []interface{}) *models.OperatingSystemSpec {
 
 	}
 
+	if v, ok := in["rhel"]; ok {
+		obj.Rhel = expandRhel(v.([]interface{}))
+	}
+
+	if v, ok := in["sles"]; ok {
+		obj.Sles = expandSles(v.([]interface{}))
+	}
+
+	if v, ok := in["amzn2"]; ok {
+		obj.Amzn2 = expandAmzn2(v.([]interface{}))
+	}
+
+	if v, ok := in["rockylinux"]; ok {
+		obj.Rockylinux = expandRockyLinux(v.([]interface{}))
+	}
+
 	return obj
 }
 
@@ -442,6 +735,86 @@ func expandFlatcar(p []interface{}) *models.FlatcarSpec {
 	return obj
 }
 
+func expandRhel(p []interface{}) *models.RHELSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.RHELSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["dist_upgrade_on_boot"]; ok {
+		obj.DistUpgradeOnBoot = v.(bool)
+	}
+
+	if v, ok := in["subscription_manager_user"]; ok {
+		obj.RHELSubscriptionManagerUser = v.(string)
+	}
+
+	if v, ok := in["subscription_manager_password"]; ok {
+		obj.RHELSubscriptionManagerPassword = v.(string)
+	}
+
+	if v, ok := in["offline_token"]; ok {
+		obj.RHSMOfflineToken = v.(string)
+	}
+
+	return obj
+}
+
+func expandSles(p []interface{}) *models.SLESSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.SLESSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["dist_upgrade_on_boot"]; ok {
+		obj.DistUpgradeOnBoot = v.(bool)
+	}
+
+	return obj
+}
+
+func expandAmzn2(p []interface{}) *models.AmazonLinuxSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.AmazonLinuxSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["dist_upgrade_on_boot"]; ok {
+		obj.DistUpgradeOnBoot = v.(bool)
+	}
+
+	return obj
+}
+
+func expandRockyLinux(p []interface{}) *models.RockyLinuxSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.RockyLinuxSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["dist_upgrade_on_boot"]; ok {
+		obj.DistUpgradeOnBoot = v.(bool)
+	}
+
+	return obj
+}
+
 func expandNodeVersion(p []interface{}) *models.NodeVersionInfo {
 	if len(p) < 1 {
 		return nil
@@ -499,6 +872,30 @@ func expandNodeCloudSpec(p []interface{}) *models.NodeCloudSpec {
 		obj.Azure = expandAzureNodeSpec(v.([]interface{}))
 	}
 
+	if v, ok := in["gcp"]; ok {
+		obj.Gcp = expandGCPNodeSpec(v.([]interface{}))
+	}
+
+	if v, ok := in["vsphere"]; ok {
+		obj.Vsphere = expandVSphereNodeSpec(v.([]interface{}))
+	}
+
+	if v, ok := in["hetzner"]; ok {
+		obj.Hetzner = expandHetznerNodeSpec(v.([]interface{}))
+	}
+
+	if v, ok := in["digitalocean"]; ok {
+		obj.Digitalocean = expandDigitaloceanNodeSpec(v.([]interface{}))
+	}
+
+	if v, ok := in["packet"]; ok {
+		obj.Packet = expandPacketNodeSpec(v.([]interface{}))
+	}
+
+	if v, ok := in["kubevirt"]; ok {
+		obj.Kubevirt = expandKubevirtNodeSpec(v.([]interface{}))
+	}
+
 	return obj
 }
 
@@ -650,3 +1047,194 @@ func expandAzureNodeSpec(p []interface{}) *models.AzureNodeSpec {
 
 	return obj
 }
+
+func expandGCPNodeSpec(p []interface{}) *models.GCPNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.GCPNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["zone"]; ok {
+		obj.Zone = v.(string)
+	}
+
+	if v, ok := in["machine_type"]; ok {
+		obj.MachineType = v.(string)
+	}
+
+	if v, ok := in["disk_size"]; ok {
+		obj.DiskSize = int64(v.(int))
+	}
+
+	if v, ok := in["disk_type"]; ok {
+		obj.DiskType = v.(string)
+	}
+
+	if v, ok := in["preemptible"]; ok {
+		obj.Preemptible = v.(bool)
+	}
+
+	if v, ok := in["labels"]; ok {
+		obj.Labels = make(map[string]string)
+		for key, val := range v.(map[string]interface{}) {
+			obj.Labels[key] = val.(string)
+		}
+	}
+
+	if v, ok := in["tags"]; ok {
+		for _, t := range v.([]interface{}) {
+			obj.Tags = append(obj.Tags, t.(string))
+		}
+	}
+
+	return obj
+}
+
+func expandVSphereNodeSpec(p []interface{}) *models.VSphereNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.VSphereNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["cpus"]; ok {
+		obj.CPUs = int64(v.(int))
+	}
+
+	if v, ok := in["memory"]; ok {
+		obj.Memory = int64(v.(int))
+	}
+
+	if v, ok := in["disk_size_gb"]; ok {
+		obj.DiskSizeGB = int64(v.(int))
+	}
+
+	if v, ok := in["template"]; ok {
+		obj.Template = v.(string)
+	}
+
+	return obj
+}
+
+func expandHetznerNodeSpec(p []interface{}) *models.HetznerNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.HetznerNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["type"]; ok {
+		obj.Type = strToPtr(v.(string))
+	}
+
+	if v, ok := in["network"]; ok {
+		obj.Network = v.(string)
+	}
+
+	return obj
+}
+
+func expandDigitaloceanNodeSpec(p []interface{}) *models.DigitaloceanNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.DigitaloceanNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["size"]; ok {
+		obj.Size = strToPtr(v.(string))
+	}
+
+	if v, ok := in["backups"]; ok {
+		obj.Backups = v.(bool)
+	}
+
+	if v, ok := in["ipv6"]; ok {
+		obj.IPV6 = v.(bool)
+	}
+
+	if v, ok := in["monitoring"]; ok {
+		obj.Monitoring = v.(bool)
+	}
+
+	if v, ok := in["tags"]; ok {
+		for _, t := range v.([]interface{}) {
+			obj.Tags = append(obj.Tags, t.(string))
+		}
+	}
+
+	return obj
+}
+
+func expandPacketNodeSpec(p []interface{}) *models.PacketNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.PacketNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["instance_type"]; ok {
+		obj.InstanceType = strToPtr(v.(string))
+	}
+
+	if v, ok := in["tags"]; ok {
+		for _, t := range v.([]interface{}) {
+			obj.Tags = append(obj.Tags, t.(string))
+		}
+	}
+
+	return obj
+}
+
+func expandKubevirtNodeSpec(p []interface{}) *models.KubevirtNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.KubevirtNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["cpus"]; ok {
+		obj.CPUs = strToPtr(v.(string))
+	}
+
+	if v, ok := in["memory"]; ok {
+		obj.Memory = strToPtr(v.(string))
+	}
+
+	if v, ok := in["namespace"]; ok {
+		obj.Namespace = strToPtr(v.(string))
+	}
+
+	if v, ok := in["source_url"]; ok {
+		obj.SourceURL = strToPtr(v.(string))
+	}
+
+	if v, ok := in["storage_class_name"]; ok {
+		obj.StorageClassName = strToPtr(v.(string))
+	}
+
+	if v, ok := in["pvc_size"]; ok {
+		obj.PVCSize = strToPtr(v.(string))
+	}
+
+	return obj
+}
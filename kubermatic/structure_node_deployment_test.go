@@ -23,13 +23,43 @@ func TestFlattenNodeDeploymentSpec(t *testing.T) {
 					"replicas":       int32(1),
 					"template":       []interface{}{map[string]interface{}{}},
 					"dynamic_config": true,
+					"paused":         false,
+				},
+			},
+		},
+		{
+			&models.NodeDeploymentSpec{
+				Replicas:    int32ToPtr(2),
+				MinReplicas: int32ToPtr(1),
+				MaxReplicas: int32ToPtr(5),
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"replicas":       int32(2),
+					"min_replicas":   int32(1),
+					"max_replicas":   int32(5),
+					"dynamic_config": false,
+					"paused":         false,
+				},
+			},
+		},
+		{
+			&models.NodeDeploymentSpec{
+				Replicas: int32ToPtr(3),
+				Paused:   true,
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"replicas":       int32(3),
+					"dynamic_config": false,
+					"paused":         true,
 				},
 			},
 		},
 		{
 			&models.NodeDeploymentSpec{},
 			[]interface{}{
-				map[string]interface{}{"dynamic_config": false},
+				map[string]interface{}{"dynamic_config": false, "paused": false},
 			},
 		},
 		{
@@ -264,6 +294,90 @@ func TestFlattenOperatingSystem(t *testing.T) {
 				},
 			},
 		},
+		{
+			&models.OperatingSystemSpec{
+				Rhel: &models.RHELSpec{
+					DistUpgradeOnBoot:               true,
+					RHELSubscriptionManagerUser:     "user",
+					RHELSubscriptionManagerPassword: "password",
+					RHSMOfflineToken:                "token",
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"rhel": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot":          true,
+							"subscription_manager_user":     "user",
+							"subscription_manager_password": "password",
+							"offline_token":                 "token",
+						},
+					},
+				},
+			},
+		},
+		{
+			&models.OperatingSystemSpec{
+				Rhel: &models.RHELSpec{},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"rhel": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": false,
+						},
+					},
+				},
+			},
+		},
+		{
+			&models.OperatingSystemSpec{
+				Sles: &models.SLESSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"sles": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+		},
+		{
+			&models.OperatingSystemSpec{
+				Amzn2: &models.AmazonLinuxSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"amzn2": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+		},
+		{
+			&models.OperatingSystemSpec{
+				Rockylinux: &models.RockyLinuxSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"rockylinux": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+		},
 		{
 			&models.OperatingSystemSpec{},
 			[]interface{}{
@@ -394,230 +508,612 @@ func TestFlattenOpenstackNodeSpec(t *testing.T) {
 	}
 }
 
-func TestExpandNodeDeploymentSpec(t *testing.T) {
+func TestFlattenGCPNodeSpec(t *testing.T) {
 	cases := []struct {
-		Input          []interface{}
-		ExpectedOutput *models.NodeDeploymentSpec
+		Input          *models.GCPNodeSpec
+		ExpectedOutput []interface{}
 	}{
 		{
+			&models.GCPNodeSpec{
+				Zone:        "europe-west3-c",
+				MachineType: "n1-standard-2",
+				DiskSize:    25,
+				DiskType:    "pd-standard",
+				Preemptible: true,
+				Labels: map[string]string{
+					"foo": "bar",
+				},
+				Tags: []string{"tag-a"},
+			},
 			[]interface{}{
 				map[string]interface{}{
-					"replicas":       1,
-					"template":       []interface{}{map[string]interface{}{}},
-					"dynamic_config": true,
+					"zone":         "europe-west3-c",
+					"machine_type": "n1-standard-2",
+					"disk_size":    int64(25),
+					"disk_type":    "pd-standard",
+					"preemptible":  true,
+					"labels": map[string]string{
+						"foo": "bar",
+					},
+					"tags": []string{"tag-a"},
 				},
 			},
-			&models.NodeDeploymentSpec{
-				Replicas:      int32ToPtr(1),
-				Template:      &models.NodeSpec{},
-				DynamicConfig: true,
-			},
 		},
 		{
-
+			&models.GCPNodeSpec{},
 			[]interface{}{
-				map[string]interface{}{},
+				map[string]interface{}{
+					"preemptible": false,
+				},
 			},
-			&models.NodeDeploymentSpec{},
 		},
 		{
-			[]interface{}{},
 			nil,
+			[]interface{}{},
 		},
 	}
 
 	for _, tc := range cases {
-		output := expandNodeDeploymentSpec(tc.Input)
+		output := flattenGCPNodeSpec(tc.Input)
 		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
 			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
 		}
 	}
 }
 
-func TestExpandNodeSpec(t *testing.T) {
+func TestFlattenVSphereNodeSpec(t *testing.T) {
 	cases := []struct {
-		Input          []interface{}
-		ExpectedOutput *models.NodeSpec
+		Input          *models.VSphereNodeSpec
+		ExpectedOutput []interface{}
 	}{
 		{
+			&models.VSphereNodeSpec{
+				CPUs:       2,
+				Memory:     4096,
+				DiskSizeGB: 20,
+				Template:   "ubuntu-template",
+			},
 			[]interface{}{
 				map[string]interface{}{
-					"operating_system": []interface{}{
-						map[string]interface{}{
-							"ubuntu": []interface{}{
-								map[string]interface{}{
-									"dist_upgrade_on_boot": false,
-								},
-							},
-						},
-					},
-					"taints": []interface{}{
-						map[string]interface{}{
-							"key":    "key1",
-							"value":  "value1",
-							"effect": "NoSchedule",
-						},
-						map[string]interface{}{
-							"key":    "key2",
-							"value":  "value2",
-							"effect": "NoSchedule",
-						},
-					},
-					"cloud": []interface{}{
-						map[string]interface{}{
-							"aws": []interface{}{
-								map[string]interface{}{
-									"assign_public_ip": false,
-								},
-							},
-						},
-					},
-					"labels": map[string]interface{}{
-						"foo": "bar",
-					},
-					"versions": []interface{}{
-						map[string]interface{}{
-							"kubelet": "1.15.6",
-						},
-					},
-				},
-			},
-			&models.NodeSpec{
-				OperatingSystem: &models.OperatingSystemSpec{
-					Ubuntu: &models.UbuntuSpec{},
-				},
-				Taints: []*models.TaintSpec{
-					{
-						Key:    "key1",
-						Value:  "value1",
-						Effect: "NoSchedule",
-					},
-					{
-						Key:    "key2",
-						Value:  "value2",
-						Effect: "NoSchedule",
-					},
-				},
-				Cloud: &models.NodeCloudSpec{
-					Aws: &models.AWSNodeSpec{},
-				},
-				Labels: map[string]string{
-					"foo": "bar",
-				},
-				Versions: &models.NodeVersionInfo{
-					Kubelet: "1.15.6",
+					"cpus":         int64(2),
+					"memory":       int64(4096),
+					"disk_size_gb": int64(20),
+					"template":     "ubuntu-template",
 				},
 			},
 		},
 		{
-
+			&models.VSphereNodeSpec{},
 			[]interface{}{
 				map[string]interface{}{},
 			},
-			&models.NodeSpec{},
 		},
 		{
-			[]interface{}{},
 			nil,
+			[]interface{}{},
 		},
 	}
 
 	for _, tc := range cases {
-		output := expandNodeSpec(tc.Input)
+		output := flattenVSphereNodeSpec(tc.Input)
 		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
 			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
 		}
 	}
 }
 
-func TestExpandOperatingSystem(t *testing.T) {
+func TestFlattenHetznerNodeSpec(t *testing.T) {
 	cases := []struct {
-		Input          []interface{}
-		ExpectedOutput *models.OperatingSystemSpec
+		Input          *models.HetznerNodeSpec
+		ExpectedOutput []interface{}
 	}{
 		{
+			&models.HetznerNodeSpec{
+				Type:    strToPtr("cx21"),
+				Network: "network",
+			},
 			[]interface{}{
 				map[string]interface{}{
-					"centos": []interface{}{
-						map[string]interface{}{
-							"dist_upgrade_on_boot": true,
-						},
-					},
-				},
-			},
-			&models.OperatingSystemSpec{
-				Centos: &models.CentOSSpec{
-					DistUpgradeOnBoot: true,
+					"type":    "cx21",
+					"network": "network",
 				},
 			},
 		},
 		{
+			&models.HetznerNodeSpec{},
 			[]interface{}{
-				map[string]interface{}{
-					"ubuntu": []interface{}{
-						map[string]interface{}{
-							"dist_upgrade_on_boot": true,
-						},
-					},
-				},
-			},
-			&models.OperatingSystemSpec{
-				Ubuntu: &models.UbuntuSpec{
-					DistUpgradeOnBoot: true,
-				},
+				map[string]interface{}{},
 			},
 		},
 		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenHetznerNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenDigitaloceanNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.DigitaloceanNodeSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.DigitaloceanNodeSpec{
+				Size:       strToPtr("s-2vcpu-4gb"),
+				Backups:    true,
+				IPV6:       true,
+				Monitoring: false,
+				Tags:       []string{"tag"},
+			},
 			[]interface{}{
 				map[string]interface{}{
-					"flatcar": []interface{}{
-						map[string]interface{}{
-							"disable_auto_update": true,
-						},
-					},
-				},
-			},
-			&models.OperatingSystemSpec{
-				Flatcar: &models.FlatcarSpec{
-					DisableAutoUpdate: true,
+					"size":       "s-2vcpu-4gb",
+					"backups":    true,
+					"ipv6":       true,
+					"monitoring": false,
+					"tags":       []string{"tag"},
 				},
 			},
 		},
 		{
-
+			&models.DigitaloceanNodeSpec{},
 			[]interface{}{
-				map[string]interface{}{},
+				map[string]interface{}{
+					"backups":    false,
+					"ipv6":       false,
+					"monitoring": false,
+				},
 			},
-			&models.OperatingSystemSpec{},
 		},
 		{
-			[]interface{}{},
 			nil,
+			[]interface{}{},
 		},
 	}
 
 	for _, tc := range cases {
-		output := expandOperatingSystem(tc.Input)
+		output := flattenDigitaloceanNodeSpec(tc.Input)
 		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
 			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
 		}
 	}
 }
 
-func TestExpandAWSNodeSpec(t *testing.T) {
+func TestFlattenPacketNodeSpec(t *testing.T) {
 	cases := []struct {
-		Input          []interface{}
-		ExpectedOutput *models.AWSNodeSpec
+		Input          *models.PacketNodeSpec
+		ExpectedOutput []interface{}
 	}{
 		{
+			&models.PacketNodeSpec{
+				InstanceType: strToPtr("c3.small.x86"),
+				Tags:         []string{"tag"},
+			},
 			[]interface{}{
 				map[string]interface{}{
-					"ami":               "ami-5731123e",
-					"assign_public_ip":  true,
-					"availability_zone": "eu-west-1",
-					"instance_type":     "t3.small",
-					"subnet_id":         "subnet-53485",
-					"tags": map[string]interface{}{
+					"instance_type": "c3.small.x86",
+					"tags":          []string{"tag"},
+				},
+			},
+		},
+		{
+			&models.PacketNodeSpec{},
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenPacketNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenKubevirtNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.KubevirtNodeSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.KubevirtNodeSpec{
+				CPUs:             strToPtr("2"),
+				Memory:           strToPtr("2048M"),
+				Namespace:        strToPtr("kube-system"),
+				SourceURL:        strToPtr("http://images/ubuntu.img"),
+				StorageClassName: strToPtr("standard"),
+				PVCSize:          strToPtr("10Gi"),
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"cpus":               "2",
+					"memory":             "2048M",
+					"namespace":          "kube-system",
+					"source_url":         "http://images/ubuntu.img",
+					"storage_class_name": "standard",
+					"pvc_size":           "10Gi",
+				},
+			},
+		},
+		{
+			&models.KubevirtNodeSpec{},
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenKubevirtNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandNodeDeploymentSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.NodeDeploymentSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"replicas":       1,
+					"template":       []interface{}{map[string]interface{}{}},
+					"dynamic_config": true,
+				},
+			},
+			&models.NodeDeploymentSpec{
+				Replicas:      int32ToPtr(1),
+				Template:      &models.NodeSpec{},
+				DynamicConfig: true,
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"replicas":     2,
+					"min_replicas": 1,
+					"max_replicas": 5,
+				},
+			},
+			&models.NodeDeploymentSpec{
+				Replicas:    int32ToPtr(2),
+				MinReplicas: int32ToPtr(1),
+				MaxReplicas: int32ToPtr(5),
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"replicas":     0,
+					"min_replicas": 0,
+					"max_replicas": 3,
+				},
+			},
+			&models.NodeDeploymentSpec{
+				Replicas:    int32ToPtr(0),
+				MinReplicas: int32ToPtr(0),
+				MaxReplicas: int32ToPtr(3),
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"replicas": 2,
+					"paused":   true,
+				},
+			},
+			&models.NodeDeploymentSpec{
+				Replicas: int32ToPtr(2),
+				Paused:   true,
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"replicas":     1,
+					"min_replicas": 0,
+					"max_replicas": 0,
+				},
+			},
+			&models.NodeDeploymentSpec{
+				Replicas: int32ToPtr(1),
+			},
+		},
+		{
+
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.NodeDeploymentSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNodeDeploymentSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.NodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"operating_system": []interface{}{
+						map[string]interface{}{
+							"ubuntu": []interface{}{
+								map[string]interface{}{
+									"dist_upgrade_on_boot": false,
+								},
+							},
+						},
+					},
+					"taints": []interface{}{
+						map[string]interface{}{
+							"key":    "key1",
+							"value":  "value1",
+							"effect": "NoSchedule",
+						},
+						map[string]interface{}{
+							"key":    "key2",
+							"value":  "value2",
+							"effect": "NoSchedule",
+						},
+					},
+					"cloud": []interface{}{
+						map[string]interface{}{
+							"aws": []interface{}{
+								map[string]interface{}{
+									"assign_public_ip": false,
+								},
+							},
+						},
+					},
+					"labels": map[string]interface{}{
+						"foo": "bar",
+					},
+					"versions": []interface{}{
+						map[string]interface{}{
+							"kubelet": "1.15.6",
+						},
+					},
+				},
+			},
+			&models.NodeSpec{
+				OperatingSystem: &models.OperatingSystemSpec{
+					Ubuntu: &models.UbuntuSpec{},
+				},
+				Taints: []*models.TaintSpec{
+					{
+						Key:    "key1",
+						Value:  "value1",
+						Effect: "NoSchedule",
+					},
+					{
+						Key:    "key2",
+						Value:  "value2",
+						Effect: "NoSchedule",
+					},
+				},
+				Cloud: &models.NodeCloudSpec{
+					Aws: &models.AWSNodeSpec{},
+				},
+				Labels: map[string]string{
+					"foo": "bar",
+				},
+				Versions: &models.NodeVersionInfo{
+					Kubelet: "1.15.6",
+				},
+			},
+		},
+		{
+
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.NodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandOperatingSystem(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.OperatingSystemSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"centos": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Centos: &models.CentOSSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"ubuntu": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Ubuntu: &models.UbuntuSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"flatcar": []interface{}{
+						map[string]interface{}{
+							"disable_auto_update": true,
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Flatcar: &models.FlatcarSpec{
+					DisableAutoUpdate: true,
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"rhel": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot":          true,
+							"subscription_manager_user":     "user",
+							"subscription_manager_password": "password",
+							"offline_token":                 "token",
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Rhel: &models.RHELSpec{
+					DistUpgradeOnBoot:               true,
+					RHELSubscriptionManagerUser:     "user",
+					RHELSubscriptionManagerPassword: "password",
+					RHSMOfflineToken:                "token",
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"sles": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Sles: &models.SLESSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"amzn2": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Amzn2: &models.AmazonLinuxSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"rockylinux": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Rockylinux: &models.RockyLinuxSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+		},
+		{
+
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.OperatingSystemSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandOperatingSystem(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandAWSNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.AWSNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"ami":               "ami-5731123e",
+					"assign_public_ip":  true,
+					"availability_zone": "eu-west-1",
+					"instance_type":     "t3.small",
+					"subnet_id":         "subnet-53485",
+					"tags": map[string]interface{}{
 						"foo": "bar",
 					},
 					"disk_size":   25,
@@ -757,3 +1253,259 @@ func TestExpandAzureNodeSpec(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandGCPNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.GCPNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"zone":         "europe-west3-c",
+					"machine_type": "n1-standard-2",
+					"disk_size":    25,
+					"disk_type":    "pd-standard",
+					"preemptible":  true,
+					"labels": map[string]interface{}{
+						"foo": "bar",
+					},
+					"tags": []interface{}{"tag-a"},
+				},
+			},
+			&models.GCPNodeSpec{
+				Zone:        "europe-west3-c",
+				MachineType: "n1-standard-2",
+				DiskSize:    25,
+				DiskType:    "pd-standard",
+				Preemptible: true,
+				Labels: map[string]string{
+					"foo": "bar",
+				},
+				Tags: []string{"tag-a"},
+			},
+		},
+		{
+
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.GCPNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandGCPNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandVSphereNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.VSphereNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"cpus":         2,
+					"memory":       4096,
+					"disk_size_gb": 20,
+					"template":     "ubuntu-template",
+				},
+			},
+			&models.VSphereNodeSpec{
+				CPUs:       2,
+				Memory:     4096,
+				DiskSizeGB: 20,
+				Template:   "ubuntu-template",
+			},
+		},
+		{
+
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.VSphereNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandVSphereNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandHetznerNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.HetznerNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"type":    "cx21",
+					"network": "network",
+				},
+			},
+			&models.HetznerNodeSpec{
+				Type:    strToPtr("cx21"),
+				Network: "network",
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.HetznerNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandHetznerNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandDigitaloceanNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.DigitaloceanNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"size":       "s-2vcpu-4gb",
+					"backups":    true,
+					"ipv6":       true,
+					"monitoring": true,
+					"tags":       []interface{}{"tag"},
+				},
+			},
+			&models.DigitaloceanNodeSpec{
+				Size:       strToPtr("s-2vcpu-4gb"),
+				Backups:    true,
+				IPV6:       true,
+				Monitoring: true,
+				Tags:       []string{"tag"},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.DigitaloceanNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandDigitaloceanNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandPacketNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.PacketNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"instance_type": "c3.small.x86",
+					"tags":          []interface{}{"tag"},
+				},
+			},
+			&models.PacketNodeSpec{
+				InstanceType: strToPtr("c3.small.x86"),
+				Tags:         []string{"tag"},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.PacketNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandPacketNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandKubevirtNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.KubevirtNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"cpus":               "2",
+					"memory":             "2048M",
+					"namespace":          "kube-system",
+					"source_url":         "http://images/ubuntu.img",
+					"storage_class_name": "standard",
+					"pvc_size":           "10Gi",
+				},
+			},
+			&models.KubevirtNodeSpec{
+				CPUs:             strToPtr("2"),
+				Memory:           strToPtr("2048M"),
+				Namespace:        strToPtr("kube-system"),
+				SourceURL:        strToPtr("http://images/ubuntu.img"),
+				StorageClassName: strToPtr("standard"),
+				PVCSize:          strToPtr("10Gi"),
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.KubevirtNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandKubevirtNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package kubermatic
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+func TestFlattenNodes(t *testing.T) {
+	cases := []struct {
+		Input          []*models.Node
+		ExpectedOutput []interface{}
+	}{
+		{
+			[]*models.Node{
+				{
+					ID:   "machine-b",
+					Name: "node-b",
+					Spec: &models.NodeSpec{
+						OperatingSystem: &models.OperatingSystemSpec{
+							Ubuntu: &models.UbuntuSpec{},
+						},
+					},
+					Status: &models.NodeStatus{
+						MachineName: "machine-b",
+						Addresses: []*models.NodeAddress{
+							{Type: "InternalIP", Address: "10.0.0.2"},
+							{Type: "ExternalIP", Address: "192.0.2.2"},
+							{Type: "Hostname", Address: "node-b"},
+						},
+						NodeInfo: &models.NodeSystemInfo{
+							Architecture:            "amd64",
+							ContainerRuntime:        "containerd",
+							ContainerRuntimeVersion: "1.4.12",
+							KernelVersion:           "5.4.0-91-generic",
+							KubeletVersion:          "v1.21.8",
+							OperatingSystem:         "linux",
+						},
+					},
+				},
+				{
+					ID:   "machine-a",
+					Name: "machine-a",
+					Status: &models.NodeStatus{
+						MachineName:  "machine-a",
+						ErrorReason:  "CreateMachineError",
+						ErrorMessage: "quota exceeded",
+					},
+				},
+				nil,
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"id":                 "machine-a",
+					"name":               "machine-a",
+					"machine_name":       "machine-a",
+					"creation_timestamp": "0001-01-01T00:00:00.000Z",
+					"deletion_timestamp": "0001-01-01T00:00:00.000Z",
+					"addresses":          []interface{}{},
+					"internal_ips":       []interface{}{},
+					"external_ips":       []interface{}{},
+					"ready":              false,
+					"error_reason":       "CreateMachineError",
+					"error_message":      "quota exceeded",
+				},
+				map[string]interface{}{
+					"id":                 "machine-b",
+					"name":               "node-b",
+					"machine_name":       "machine-b",
+					"creation_timestamp": "0001-01-01T00:00:00.000Z",
+					"deletion_timestamp": "0001-01-01T00:00:00.000Z",
+					"addresses": []interface{}{
+						map[string]interface{}{"type": "InternalIP", "address": "10.0.0.2"},
+						map[string]interface{}{"type": "ExternalIP", "address": "192.0.2.2"},
+						map[string]interface{}{"type": "Hostname", "address": "node-b"},
+					},
+					"internal_ips":      []interface{}{"10.0.0.2"},
+					"external_ips":      []interface{}{"192.0.2.2"},
+					"os_distribution":   "ubuntu",
+					"operating_system":  "linux",
+					"kernel_version":    "5.4.0-91-generic",
+					"architecture":      "amd64",
+					"container_runtime": "containerd 1.4.12",
+					"kubelet_version":   "v1.21.8",
+					"ready":             true,
+					"error_reason":      "",
+					"error_message":     "",
+				},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenNodes(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
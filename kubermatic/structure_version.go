@@ -0,0 +1,130 @@
+package kubermatic
+
+import (
+	"sort"
+
+	"github.com/hashicorp/go-version"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+// flattenMasterVersions flattens versions sorted from the oldest to the newest,
+// versions which are not valid semver are skipped.
+func flattenMasterVersions(in []*models.MasterVersion) []interface{} {
+	vs := sortedMasterVersions(in)
+
+	att := make([]interface{}, len(vs))
+	for i, v := range vs {
+		att[i] = map[string]interface{}{
+			"version": v.ver.Original(),
+			"default": v.def,
+		}
+	}
+	return att
+}
+
+// latestMasterVersion returns the newest of the given versions. If prefixOf is
+// set, only versions with the same major and minor version are considered.
+func latestMasterVersion(in []*models.MasterVersion, prefixOf string) string {
+	var major, minor int
+	if prefixOf != "" {
+		v, err := version.NewVersion(prefixOf)
+		if err != nil {
+			return ""
+		}
+		major, minor = v.Segments()[0], v.Segments()[1]
+	}
+
+	vs := sortedMasterVersions(in)
+	for i := len(vs) - 1; i >= 0; i-- {
+		s := vs[i].ver.Segments()
+		if prefixOf == "" || (s[0] == major && s[1] == minor) {
+			return vs[i].ver.Original()
+		}
+	}
+	return ""
+}
+
+// filterMasterVersions returns versions matching the constraint, e.g. "~> 1.22",
+// versions which are not valid semver are skipped.
+func filterMasterVersions(in []*models.MasterVersion, constraint string) ([]*models.MasterVersion, error) {
+	if constraint == "" {
+		return in, nil
+	}
+
+	c, err := version.NewConstraint(constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.MasterVersion
+	for _, v := range in {
+		if v == nil {
+			continue
+		}
+		s, ok := v.Version.(string)
+		if !ok {
+			continue
+		}
+		ver, err := version.NewVersion(s)
+		if err != nil {
+			continue
+		}
+		if c.Check(ver) {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+// unrestrictedMasterVersions drops versions restricted by the kubelet versions
+// of the cluster's nodes, the API rejects upgrades to them.
+func unrestrictedMasterVersions(in []*models.MasterVersion) []*models.MasterVersion {
+	var out []*models.MasterVersion
+	for _, v := range in {
+		if v != nil && !v.RestrictedByKubeletVersion {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// defaultMasterVersion returns the version flagged as default.
+func defaultMasterVersion(in []*models.MasterVersion) string {
+	for _, v := range in {
+		if v == nil || !v.Default {
+			continue
+		}
+		if s, ok := v.Version.(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
+type masterVersion struct {
+	ver *version.Version
+	def bool
+}
+
+func sortedMasterVersions(in []*models.MasterVersion) []masterVersion {
+	var vs []masterVersion
+	for _, v := range in {
+		if v == nil {
+			continue
+		}
+		s, ok := v.Version.(string)
+		if !ok {
+			continue
+		}
+		ver, err := version.NewVersion(s)
+		if err != nil {
+			continue
+		}
+		vs = append(vs, masterVersion{ver: ver, def: v.Default})
+	}
+
+	sort.SliceStable(vs, func(i, j int) bool {
+		return vs[i].ver.LessThan(vs[j].ver)
+	})
+	return vs
+}
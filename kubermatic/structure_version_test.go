@@ -0,0 +1,150 @@
+package kubermatic
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+func TestFlattenMasterVersions(t *testing.T) {
+	cases := []struct {
+		Input          []*models.MasterVersion
+		ExpectedOutput []interface{}
+	}{
+		{
+			[]*models.MasterVersion{
+				{Version: "1.22.5", Default: true},
+				{Version: "1.21.8"},
+				{Version: "invalid"},
+				{Version: "1.22.10"},
+				nil,
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"version": "1.21.8",
+					"default": false,
+				},
+				map[string]interface{}{
+					"version": "1.22.5",
+					"default": true,
+				},
+				map[string]interface{}{
+					"version": "1.22.10",
+					"default": false,
+				},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenMasterVersions(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestLatestMasterVersion(t *testing.T) {
+	versions := []*models.MasterVersion{
+		{Version: "1.21.8"},
+		{Version: "1.22.10"},
+		{Version: "1.21.14"},
+		{Version: "1.22.5", Default: true},
+	}
+
+	cases := []struct {
+		PrefixOf       string
+		ExpectedOutput string
+	}{
+		{"", "1.22.10"},
+		{"1.21.2", "1.21.14"},
+		{"1.20.0", ""},
+		{"invalid", ""},
+	}
+
+	for _, tc := range cases {
+		if output := latestMasterVersion(versions, tc.PrefixOf); output != tc.ExpectedOutput {
+			t.Fatalf("Unexpected latest version for %q: want %q, got %q", tc.PrefixOf, tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestFilterMasterVersions(t *testing.T) {
+	versions := []*models.MasterVersion{
+		{Version: "1.21.8"},
+		{Version: "1.22.5", Default: true},
+		{Version: "invalid"},
+		{Version: "1.22.10"},
+	}
+
+	cases := []struct {
+		Constraint     string
+		ExpectedOutput []*models.MasterVersion
+		ExpectError    bool
+	}{
+		{
+			"",
+			versions,
+			false,
+		},
+		{
+			"~> 1.22.0",
+			[]*models.MasterVersion{
+				{Version: "1.22.5", Default: true},
+				{Version: "1.22.10"},
+			},
+			false,
+		},
+		{
+			">= 2.0",
+			nil,
+			false,
+		},
+		{
+			"invalid",
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := filterMasterVersions(versions, tc.Constraint)
+		if tc.ExpectError != (err != nil) {
+			t.Fatalf("Unexpected error for %q: %v", tc.Constraint, err)
+		}
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output for %q: mismatch (-want +got):\n%s", tc.Constraint, diff)
+		}
+	}
+}
+
+func TestUnrestrictedMasterVersions(t *testing.T) {
+	upgrades := []*models.MasterVersion{
+		{Version: "1.22.5"},
+		{Version: "1.22.10", RestrictedByKubeletVersion: true},
+		nil,
+		{Version: "1.23.4", RestrictedByKubeletVersion: true},
+		{Version: "1.22.8"},
+	}
+
+	output := unrestrictedMasterVersions(upgrades)
+	expected := []*models.MasterVersion{
+		{Version: "1.22.5"},
+		{Version: "1.22.8"},
+	}
+	if diff := cmp.Diff(expected, output); diff != "" {
+		t.Fatalf("Unexpected versions: mismatch (-want +got):\n%s", diff)
+	}
+
+	if latest := latestMasterVersion(output, ""); latest != "1.22.8" {
+		t.Errorf("Unexpected latest version: want %q, got %q", "1.22.8", latest)
+	}
+	if latest := latestMasterVersion(output, "1.22.2"); latest != "1.22.8" {
+		t.Errorf("Unexpected latest patch version: want %q, got %q", "1.22.8", latest)
+	}
+}
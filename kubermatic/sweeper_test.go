@@ -9,7 +9,7 @@ import (
 
 func sharedConfigForRegion(_ string) (*kubermaticProviderMeta, error) {
 	host := os.Getenv("KUBERMATIC_HOST")
-	client, err := newClient(host)
+	client, err := newClient(host, nil)
 	if err != nil {
 		return nil, fmt.Errorf("create client %v", err)
 	}
@@ -20,8 +20,8 @@ func sharedConfigForRegion(_ string) (*kubermaticProviderMeta, error) {
 	}
 	log := zap.NewNop().Sugar()
 	return &kubermaticProviderMeta{
-		client,
-		auth,
-		log,
+		client: client,
+		auth:   auth,
+		log:    log,
 	}, nil
 }
@@ -0,0 +1,113 @@
+package kubermatic
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"go.uber.org/zap"
+)
+
+// transportConfig holds the HTTP settings used to talk to the Kubermatic API.
+type transportConfig struct {
+	// caCertificate is a PEM encoded CA bundle trusted in addition to the system roots
+	caCertificate string
+	// caFile is a path to a PEM encoded CA bundle trusted in addition to the system roots
+	caFile             string
+	insecureSkipVerify bool
+	// proxyURL overrides proxies configured in the environment
+	proxyURL string
+	// timeout limits every request, zero means no timeout
+	timeout time.Duration
+	headers map[string]string
+	// maxRetries is the number of retries of failed requests, zero disables retries
+	maxRetries int
+	// retryMaxWait caps the wait between two attempts
+	retryMaxWait time.Duration
+	log          *zap.SugaredLogger
+}
+
+func newHTTPClient(cfg transportConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: cfg.insecureSkipVerify,
+	}
+	if cfg.caCertificate != "" || cfg.caFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if cfg.caCertificate != "" && !pool.AppendCertsFromPEM([]byte(cfg.caCertificate)) {
+			return nil, fmt.Errorf("no valid certificates found in ca_certificate")
+		}
+		if cfg.caFile != "" {
+			p, err := homedir.Expand(cfg.caFile)
+			if err != nil {
+				return nil, err
+			}
+			pem, err := ioutil.ReadFile(p)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read ca_file: %v", err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no valid certificates found in ca_file '%s'", cfg.caFile)
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.proxyURL != "" {
+		u, err := url.Parse(cfg.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %v", err)
+		}
+		transport.Proxy = http.ProxyURL(u)
+	}
+
+	var rt http.RoundTripper = transport
+	if len(cfg.headers) > 0 {
+		rt = &headerRoundTripper{
+			headers: cfg.headers,
+			next:    rt,
+		}
+	}
+
+	if cfg.maxRetries > 0 {
+		rt = newRetryRoundTripper(rt, cfg.maxRetries, cfg.retryMaxWait, cfg.log)
+	}
+
+	return &http.Client{
+		Transport: rt,
+		Timeout:   cfg.timeout,
+	}, nil
+}
+
+// newOIDCHTTPClient returns a client for the OIDC issuer, using the TLS, proxy,
+// timeout and retry settings of the API client. Custom headers are meant for
+// the Kubermatic API only and are not sent to the issuer.
+func newOIDCHTTPClient(cfg transportConfig) (*http.Client, error) {
+	cfg.headers = nil
+	return newHTTPClient(cfg)
+}
+
+// headerRoundTripper adds headers to every request.
+type headerRoundTripper struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+func (rt *headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// round trippers must not modify the original request
+	req = req.Clone(req.Context())
+	for k, v := range rt.headers {
+		req.Header.Set(k, v)
+	}
+	return rt.next.RoundTrip(req)
+}
@@ -0,0 +1,175 @@
+package kubermatic
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestTLSServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, string) {
+	t.Helper()
+	srv := httptest.NewTLSServer(handler)
+	t.Cleanup(srv.Close)
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	return srv, string(ca)
+}
+
+func TestNewHTTPClientTLS(t *testing.T) {
+	srv, ca := newTestTLSServer(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := ioutil.WriteFile(caFile, []byte(ca), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		Name        string
+		Config      transportConfig
+		ExpectError bool
+	}{
+		{
+			Name:        "untrusted certificate",
+			Config:      transportConfig{},
+			ExpectError: true,
+		},
+		{
+			Name:   "ca certificate",
+			Config: transportConfig{caCertificate: ca},
+		},
+		{
+			Name:   "ca file",
+			Config: transportConfig{caFile: caFile},
+		},
+		{
+			Name:   "insecure skip verify",
+			Config: transportConfig{insecureSkipVerify: true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			c, err := newHTTPClient(tc.Config)
+			if err != nil {
+				t.Fatalf("Unexpected error creating client: %v", err)
+			}
+			resp, err := c.Get(srv.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if tc.ExpectError != (err != nil) {
+				t.Fatalf("Unexpected request error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientInvalidCA(t *testing.T) {
+	if _, err := newHTTPClient(transportConfig{caCertificate: "invalid"}); err == nil {
+		t.Fatal("Expected error for invalid ca_certificate")
+	}
+	if _, err := newHTTPClient(transportConfig{caFile: filepath.Join(os.TempDir(), "does-not-exist.pem")}); err == nil {
+		t.Fatal("Expected error for missing ca_file")
+	}
+}
+
+func TestNewHTTPClientHeaders(t *testing.T) {
+	var got http.Header
+	srv, ca := newTestTLSServer(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	})
+
+	c, err := newHTTPClient(transportConfig{
+		caCertificate: ca,
+		headers: map[string]string{
+			"X-Custom": "value",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected request error: %v", err)
+	}
+	resp.Body.Close()
+
+	if v := got.Get("X-Custom"); v != "value" {
+		t.Fatalf("Unexpected X-Custom header: want %q, got %q", "value", v)
+	}
+	if req.Header.Get("X-Custom") != "" {
+		t.Fatal("Original request must not be modified")
+	}
+}
+
+func TestNewOIDCHTTPClient(t *testing.T) {
+	var got http.Header
+	srv, ca := newTestTLSServer(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	})
+
+	c, err := newOIDCHTTPClient(transportConfig{
+		caCertificate: ca,
+		headers: map[string]string{
+			"X-Custom": "value",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected request error: %v", err)
+	}
+	resp.Body.Close()
+
+	if v := got.Get("X-Custom"); v != "" {
+		t.Fatalf("Custom headers must not be sent to the issuer, got X-Custom %q", v)
+	}
+}
+
+func TestNewHTTPClientTimeout(t *testing.T) {
+	srv, ca := newTestTLSServer(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	})
+
+	c, err := newHTTPClient(transportConfig{caCertificate: ca, timeout: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp, err := c.Get(srv.URL); err == nil {
+		resp.Body.Close()
+		t.Fatal("Expected timeout error")
+	}
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+	proxied := false
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = true
+	}))
+	defer proxy.Close()
+
+	c, err := newHTTPClient(transportConfig{proxyURL: proxy.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := c.Get("http://kubermatic.example.com/api/v1/projects")
+	if err != nil {
+		t.Fatalf("Unexpected request error: %v", err)
+	}
+	resp.Body.Close()
+
+	if !proxied {
+		t.Fatal("Expected request to go through the proxy")
+	}
+}
@@ -6,7 +6,6 @@ import (
 	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/kubermatic/go-kubermatic/client/project"
-	"github.com/kubermatic/go-kubermatic/client/versions"
 	"github.com/kubermatic/go-kubermatic/models"
 )
 
@@ -48,27 +47,81 @@ func validateNodeSpecMatchesCluster() schema.CustomizeDiffFunc {
 	}
 }
 
-func getClusterCloudProvider(c *models.Cluster) (string, error) {
-	switch {
-	case c.Spec.Cloud.Bringyourown != nil:
-		return "bringyourown", nil
-	case c.Spec.Cloud.Aws != nil:
-		return "aws", nil
-	case c.Spec.Cloud.Openstack != nil:
-		return "openstack", nil
-	case c.Spec.Cloud.Azure != nil:
-		return "azure", nil
+// operatingSystems are the names of the operating system blocks of a node template.
+var operatingSystems = []string{"ubuntu", "centos", "flatcar", "rhel", "sles", "amzn2", "rockylinux"}
+
+func validateOnlyOneOperatingSystemSpecified() schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		key := "spec.0.template.0.operating_system"
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+		return validateOnlyOneOperatingSystem(d.Get(key).([]interface{}))
+	}
+}
+
+func validateOnlyOneOperatingSystem(p []interface{}) error {
+	var specified []string
+	if len(p) > 0 && p[0] != nil {
+		in := p[0].(map[string]interface{})
+		for _, name := range operatingSystems {
+			if v, ok := in[name].([]interface{}); ok && len(v) > 0 {
+				specified = append(specified, name)
+			}
+		}
+	}
+
+	switch len(specified) {
+	case 0:
+		return fmt.Errorf("one operating system must be specified: %v", operatingSystems)
+	case 1:
+		return nil
 	default:
-		return "", fmt.Errorf("could not find cloud provider for cluster")
+		return fmt.Errorf("only one operating system must be specified: %v", specified)
+	}
+}
+
+func validateAutoscalingReplicas() schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		return validateReplicaBounds(
+			d.Get("spec.0.replicas").(int),
+			d.Get("spec.0.min_replicas").(int),
+			d.Get("spec.0.max_replicas").(int),
+		)
+	}
+}
 
+func validateReplicaBounds(replicas, min, max int) error {
+	if max == 0 {
+		if min > 0 {
+			return fmt.Errorf("min_replicas requires max_replicas to be set")
+		}
+		return nil
+	}
+	if min > max {
+		return fmt.Errorf("min_replicas %d must not be greater than max_replicas %d", min, max)
 	}
+	if replicas < min || replicas > max {
+		return fmt.Errorf("replicas %d must be between min_replicas %d and max_replicas %d", replicas, min, max)
+	}
+	return nil
+}
+
+func getClusterCloudProvider(c *models.Cluster) (string, error) {
+	if c.Spec != nil && c.Spec.Cloud != nil {
+		for _, p := range cloudProviders {
+			if p.inUse(c.Spec.Cloud) {
+				return p.name, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("could not find cloud provider for cluster")
 }
 
 func validateProviderMatchesCluster(d *schema.ResourceDiff, clusterProvider string) error {
-	var availableProviders = []string{"bringyourown", "aws", "openstack", "azure"}
 	var provider string
 
-	for _, p := range availableProviders {
+	for _, p := range cloudProviderNames() {
 		providerField := fmt.Sprintf("spec.0.template.0.cloud.0.%s", p)
 		_, ok := d.GetOk(providerField)
 		if ok {
@@ -110,20 +163,13 @@ func validateKubeletVersionIsAvailable(d *schema.ResourceDiff, k *kubermaticProv
 	version := d.Get("spec.0.template.0.versions.0.kubelet").(string)
 	versionType := "kubernetes"
 
-	p := versions.NewGetNodeUpgradesParams()
-	p.SetType(&versionType)
-	p.SetControlPlaneVersion(&clusterVersion)
-	r, err := k.client.Versions.GetNodeUpgrades(p, k.auth)
-
+	upgrades, err := getNodeUpgrades(k, versionType, clusterVersion)
 	if err != nil {
-		if e, ok := err.(*versions.GetNodeUpgradesDefault); ok && e.Payload != nil && e.Payload.Error != nil && e.Payload.Error.Message != nil {
-			return fmt.Errorf("get node_deployment upgrades: %s", *e.Payload.Error.Message)
-		}
 		return err
 	}
 
 	var availableVersions []string
-	for _, v := range r.Payload {
+	for _, v := range upgrades {
 		s, ok := v.Version.(string)
 		if ok && s == version && !v.RestrictedByKubeletVersion {
 			return nil
@@ -7,8 +7,159 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/kubermatic/go-kubermatic/models"
 )
 
+func TestGetClusterCloudProvider(t *testing.T) {
+	cases := []struct {
+		Input          *models.CloudSpec
+		ExpectedOutput string
+		ExpectError    bool
+	}{
+		{
+			&models.CloudSpec{Bringyourown: map[string]interface{}{}},
+			"bringyourown",
+			false,
+		},
+		{
+			&models.CloudSpec{Hetzner: &models.HetznerCloudSpec{}},
+			"hetzner",
+			false,
+		},
+		{
+			&models.CloudSpec{Digitalocean: &models.DigitaloceanCloudSpec{}},
+			"digitalocean",
+			false,
+		},
+		{
+			&models.CloudSpec{Packet: &models.PacketCloudSpec{}},
+			"packet",
+			false,
+		},
+		{
+			&models.CloudSpec{},
+			"",
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := getClusterCloudProvider(&models.Cluster{Spec: &models.ClusterSpec{Cloud: tc.Input}})
+		if tc.ExpectError != (err != nil) {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if output != tc.ExpectedOutput {
+			t.Fatalf("Unexpected provider: want %q, got %q", tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestValidateOnlyOneOperatingSystem(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Input       []interface{}
+		ExpectError string
+	}{
+		{
+			Name: "one",
+			Input: []interface{}{
+				map[string]interface{}{
+					"rockylinux": []interface{}{map[string]interface{}{"dist_upgrade_on_boot": false}},
+					"ubuntu":     []interface{}{},
+				},
+			},
+		},
+		{
+			Name: "empty block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"amzn2": []interface{}{nil},
+				},
+			},
+		},
+		{
+			Name: "two",
+			Input: []interface{}{
+				map[string]interface{}{
+					"rhel": []interface{}{map[string]interface{}{}},
+					"sles": []interface{}{map[string]interface{}{}},
+				},
+			},
+			ExpectError: "only one operating system must be specified: [rhel sles]",
+		},
+		{
+			Name:        "none",
+			Input:       []interface{}{map[string]interface{}{}},
+			ExpectError: "one operating system must be specified: [ubuntu centos flatcar rhel sles amzn2 rockylinux]",
+		},
+		{
+			Name:        "no block",
+			ExpectError: "one operating system must be specified: [ubuntu centos flatcar rhel sles amzn2 rockylinux]",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := validateOnlyOneOperatingSystem(tc.Input)
+			if tc.ExpectError == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.ExpectError {
+				t.Errorf("Want error '%s', got %v", tc.ExpectError, err)
+			}
+		})
+	}
+}
+
+func TestValidateReplicaBounds(t *testing.T) {
+	cases := []struct {
+		Replicas    int
+		Min         int
+		Max         int
+		ExpectError bool
+	}{
+		{Replicas: 3},
+		{Replicas: 2, Min: 1, Max: 5},
+		{Replicas: 0, Min: 0, Max: 5},
+		{Replicas: 1, Min: 1, Max: 1},
+		{Replicas: 1, Min: 2, Max: 5, ExpectError: true},
+		{Replicas: 6, Min: 2, Max: 5, ExpectError: true},
+		{Replicas: 3, Min: 4, Max: 2, ExpectError: true},
+		{Replicas: 3, Min: 1, ExpectError: true},
+	}
+
+	for _, tc := range cases {
+		err := validateReplicaBounds(tc.Replicas, tc.Min, tc.Max)
+		if tc.ExpectError != (err != nil) {
+			t.Fatalf("Unexpected error for %+v: %v", tc, err)
+		}
+	}
+}
+
+func TestAutoscaledReplicasWithinBounds(t *testing.T) {
+	cases := []struct {
+		Replicas string
+		Min      int
+		Max      int
+		Expected bool
+	}{
+		{Replicas: "4", Min: 1, Max: 5, Expected: true},
+		{Replicas: "1", Min: 1, Max: 5, Expected: true},
+		{Replicas: "7", Min: 1, Max: 5},
+		{Replicas: "4", Min: 1},
+		{Replicas: "", Min: 1, Max: 5},
+	}
+
+	for _, tc := range cases {
+		if got := autoscaledReplicasWithinBounds(tc.Replicas, tc.Min, tc.Max); got != tc.Expected {
+			t.Fatalf("Unexpected result for %+v: want %v, got %v", tc, tc.Expected, got)
+		}
+	}
+}
+
 func TestAccKubermaticNodeDeployment_ValidationAgainstCluster(t *testing.T) {
 	testName := randomTestName()
 